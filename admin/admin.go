@@ -2,12 +2,23 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
 	"sync"
 
+	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/crossdomain"
+	"github.com/ethereum-optimism/supersim/genesis"
+	"github.com/ethereum-optimism/supersim/interop"
+	opsimulator "github.com/ethereum-optimism/supersim/opsimulator"
+	"github.com/ethereum-optimism/supersim/orchestrator"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
 
 	"github.com/gin-gonic/gin"
 )
@@ -19,15 +30,32 @@ type AdminServer struct {
 	cancel context.CancelFunc
 	wg     sync.WaitGroup
 
+	orchestrator *orchestrator.Orchestrator
+
 	port uint64
 }
 
-func NewAdminServer(log log.Logger, port uint64) *AdminServer {
-	return &AdminServer{log: log, port: port}
+var (
+	errNoManifest     = errors.New("no genesis manifest, the network is forked")
+	errNoOrchestrator = errors.New("no network is running")
+)
+
+// RPCMethods are served under the `admin` namespace of the JSON-RPC endpoint
+type RPCMethods struct {
+	log          log.Logger
+	orchestrator *orchestrator.Orchestrator
+	adminServer  *AdminServer
+}
+
+func NewAdminServer(log log.Logger, port uint64, orchestrator *orchestrator.Orchestrator) *AdminServer {
+	return &AdminServer{log: log, port: port, orchestrator: orchestrator}
 }
 
 func (s *AdminServer) Start(ctx context.Context) error {
-	router := setupRouter()
+	router, err := s.setupRouter()
+	if err != nil {
+		return fmt.Errorf("failed to setup router: %w", err)
+	}
 	s.srv = &http.Server{Handler: router}
 
 	listener, err := net.Listen("tcp", fmt.Sprintf(":%d", s.port))
@@ -73,7 +101,14 @@ func (s *AdminServer) Endpoint() string {
 	return fmt.Sprintf("http://127.0.0.1:%d", s.port)
 }
 
-func setupRouter() *gin.Engine {
+// ConfigDocument describes the running chains along with the endpoint of this server
+func (s *AdminServer) ConfigDocument() *orchestrator.ConfigDocument {
+	doc := s.orchestrator.ConfigDocument()
+	doc.AdminRPC = s.Endpoint()
+	return doc
+}
+
+func (s *AdminServer) setupRouter() (*gin.Engine, error) {
 	gin.SetMode(gin.ReleaseMode)
 	router := gin.New()
 	router.Use(gin.Recovery())
@@ -81,5 +116,148 @@ func setupRouter() *gin.Engine {
 	router.GET("/ready", func(c *gin.Context) {
 		c.String(http.StatusOK, "OK")
 	})
-	return router
+
+	router.GET("/config", func(c *gin.Context) {
+		if s.orchestrator == nil {
+			c.String(http.StatusServiceUnavailable, errNoOrchestrator.Error())
+			return
+		}
+		c.JSON(http.StatusOK, s.ConfigDocument())
+	})
+
+	router.GET("/manifest", func(c *gin.Context) {
+		if s.orchestrator == nil {
+			c.String(http.StatusServiceUnavailable, errNoOrchestrator.Error())
+			return
+		}
+
+		manifest := s.orchestrator.Manifest()
+		if manifest == nil {
+			c.String(http.StatusNotFound, errNoManifest.Error())
+			return
+		}
+		c.JSON(http.StatusOK, manifest)
+	})
+
+	rpcServer := rpc.NewServer()
+	rpcMethods := &RPCMethods{log: s.log, orchestrator: s.orchestrator, adminServer: s}
+	if err := rpcServer.RegisterName("admin", rpcMethods); err != nil {
+		return nil, fmt.Errorf("failed to register admin rpc methods: %w", err)
+	}
+
+	router.POST("/", gin.WrapH(rpcServer))
+	return router, nil
+}
+
+func (m *RPCMethods) AdvanceTime(ctx context.Context, seconds math.HexOrDecimal64) (uint64, error) {
+	return m.orchestrator.AdvanceTime(ctx, uint64(seconds))
+}
+
+func (m *RPCMethods) SetTime(ctx context.Context, timestamp math.HexOrDecimal64) (uint64, error) {
+	if err := m.orchestrator.SetTime(ctx, uint64(timestamp)); err != nil {
+		return 0, err
+	}
+	return uint64(timestamp), nil
+}
+
+func (m *RPCMethods) GetConfig(ctx context.Context) (*orchestrator.ConfigDocument, error) {
+	return m.adminServer.ConfigDocument(), nil
+}
+
+func (m *RPCMethods) GetManifest(ctx context.Context) (*genesis.Manifest, error) {
+	manifest := m.orchestrator.Manifest()
+	if manifest == nil {
+		return nil, errNoManifest
+	}
+	return manifest, nil
+}
+
+// GetL2ToL2Message returns null if the message has not been indexed
+func (m *RPCMethods) GetL2ToL2Message(ctx context.Context, msgHash common.Hash) (*interop.L2ToL2MessageDocument, error) {
+	return m.orchestrator.GetL2ToL2Message(ctx, msgHash)
+}
+
+func (m *RPCMethods) ListL2ToL2Messages(ctx context.Context) ([]*interop.L2ToL2MessageDocument, error) {
+	return m.orchestrator.ListL2ToL2Messages(ctx)
+}
+
+// ListCrossDomainMessages returns the messages sent between the L1 and the L2s through the CrossDomainMessengers
+func (m *RPCMethods) ListCrossDomainMessages(ctx context.Context) ([]*crossdomain.MessageDocument, error) {
+	return m.orchestrator.ListCrossDomainMessages(ctx)
+}
+
+// GetCrossDomainMessage returns null if the message has not been indexed. The optional L2 the message is sent
+// to or from disambiguates identical messages of several L2s
+func (m *RPCMethods) GetCrossDomainMessage(ctx context.Context, msgHash common.Hash, l2ChainID *math.HexOrDecimal64) (*crossdomain.MessageDocument, error) {
+	return m.orchestrator.GetCrossDomainMessage(ctx, msgHash, optionalChainID(l2ChainID))
+}
+
+// ReplayCrossDomainMessage relays a failed message again, returning once the replay is indexed
+func (m *RPCMethods) ReplayCrossDomainMessage(ctx context.Context, msgHash common.Hash, l2ChainID *math.HexOrDecimal64) (*crossdomain.MessageDocument, error) {
+	return m.orchestrator.ReplayCrossDomainMessage(ctx, msgHash, optionalChainID(l2ChainID))
+}
+
+func optionalChainID(chainID *math.HexOrDecimal64) uint64 {
+	if chainID == nil {
+		return 0
+	}
+	return uint64(*chainID)
+}
+
+// PauseSequencer stops the inclusion of user transactions sent to the L2, deposits are still included
+func (m *RPCMethods) PauseSequencer(ctx context.Context, chainID math.HexOrDecimal64, req *orchestrator.PauseSequencerRequest) error {
+	if req == nil {
+		req = &orchestrator.PauseSequencerRequest{}
+	}
+	return m.orchestrator.PauseSequencer(ctx, uint64(chainID), req)
+}
+
+// ResumeSequencer includes the transactions queued while paused, unless the sequencing window elapsed
+func (m *RPCMethods) ResumeSequencer(ctx context.Context, chainID math.HexOrDecimal64) (*opsimulator.SequencerResumeResult, error) {
+	return m.orchestrator.ResumeSequencer(ctx, uint64(chainID))
+}
+
+func (m *RPCMethods) GetSequencerStatus(ctx context.Context) ([]*opsimulator.SequencerStatus, error) {
+	return m.orchestrator.SequencerStatuses(), nil
+}
+
+func (m *RPCMethods) Snapshot(ctx context.Context) (uint64, error) {
+	return m.orchestrator.Snapshot(ctx)
+}
+
+func (m *RPCMethods) Revert(ctx context.Context, snapshotID math.HexOrDecimal64) error {
+	return m.orchestrator.Revert(ctx, uint64(snapshotID))
+}
+
+// Fund funds the account on the specified chains, every chain when empty
+func (m *RPCMethods) Fund(ctx context.Context, account config.FundedAccount, chainIDs []math.HexOrDecimal64) error {
+	ids := make([]uint64, len(chainIDs))
+	for i, chainID := range chainIDs {
+		ids[i] = uint64(chainID)
+	}
+	return m.orchestrator.Fund(ctx, account, ids)
+}
+
+// SendERC20 bridges a SuperchainERC20 between two L2s on behalf of the account, returning once relayed
+func (m *RPCMethods) SendERC20(ctx context.Context, req orchestrator.SendERC20Request) (*orchestrator.SendERC20Result, error) {
+	return m.orchestrator.SendERC20(ctx, &req)
+}
+
+// DeploySuperchainERC20 deploys the token to the same address on every L2, minting its balances
+func (m *RPCMethods) DeploySuperchainERC20(ctx context.Context, token config.Token) (*orchestrator.SuperchainERC20Deployment, error) {
+	return m.orchestrator.DeploySuperchainERC20(ctx, token)
+}
+
+// DeployL1Token deploys the token to the L1, minting its balances, along with an OptimismMintableERC20 on every L2
+func (m *RPCMethods) DeployL1Token(ctx context.Context, token config.Token) (*orchestrator.L1TokenDeployment, error) {
+	return m.orchestrator.DeployL1Token(ctx, token)
+}
+
+// DeployCreate2 deploys the contract to the same address on every L2, and optionally the L1
+func (m *RPCMethods) DeployCreate2(ctx context.Context, req orchestrator.DeployCreate2Request) (*orchestrator.DeployCreate2Result, error) {
+	return m.orchestrator.DeployCreate2(ctx, &req)
+}
+
+func (m *RPCMethods) Refork(ctx context.Context, req orchestrator.ReforkRequest) (*orchestrator.ReforkResult, error) {
+	return m.orchestrator.Refork(ctx, &req)
 }
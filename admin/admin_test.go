@@ -16,7 +16,7 @@ func TestAdminServerBasicFunctionality(t *testing.T) {
 	testlog := testlog.Logger(t, log.LevelInfo)
 
 	ctx, cancel := context.WithCancel(context.Background())
-	adminServer := NewAdminServer(testlog, 0)
+	adminServer := NewAdminServer(testlog, 0, nil)
 	t.Cleanup(func() { cancel() })
 
 	require.NoError(t, adminServer.Start(ctx))
@@ -39,3 +39,16 @@ func TestAdminServerBasicFunctionality(t *testing.T) {
 	}
 	require.Error(t, err)
 }
+
+func TestAdminServerWithoutOrchestrator(t *testing.T) {
+	adminServer := NewAdminServer(testlog.Logger(t, log.LevelInfo), 0, nil)
+	require.NoError(t, adminServer.Start(context.Background()))
+	t.Cleanup(func() { require.NoError(t, adminServer.Stop(context.Background())) })
+
+	for _, path := range []string{"/manifest", "/config"} {
+		resp, err := http.Get(fmt.Sprintf("%s%s", adminServer.Endpoint(), path))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusServiceUnavailable, resp.StatusCode, path)
+	}
+}
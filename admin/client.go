@@ -0,0 +1,203 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/crossdomain"
+	"github.com/ethereum-optimism/supersim/genesis"
+	"github.com/ethereum-optimism/supersim/interop"
+	opsimulator "github.com/ethereum-optimism/supersim/opsimulator"
+	"github.com/ethereum-optimism/supersim/orchestrator"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Client interacts with the admin server of a running supersim instance
+type Client struct {
+	rpcClient *rpc.Client
+}
+
+func NewClient(ctx context.Context, endpoint string) (*Client, error) {
+	rpcClient, err := rpc.DialContext(ctx, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial admin server: %w", err)
+	}
+
+	return &Client{rpcClient}, nil
+}
+
+func (c *Client) Close() {
+	c.rpcClient.Close()
+}
+
+// AdvanceTime moves every chain forward by the specified number of seconds. Returns the new timestamp
+func (c *Client) AdvanceTime(ctx context.Context, seconds uint64) (uint64, error) {
+	var timestamp uint64
+	if err := c.rpcClient.CallContext(ctx, &timestamp, "admin_advanceTime", seconds); err != nil {
+		return 0, err
+	}
+	return timestamp, nil
+}
+
+// SetTime moves every chain to the specified timestamp
+func (c *Client) SetTime(ctx context.Context, timestamp uint64) error {
+	return c.rpcClient.CallContext(ctx, nil, "admin_setTime", timestamp)
+}
+
+// GetConfig returns the configuration of the running chains
+func (c *Client) GetConfig(ctx context.Context) (*orchestrator.ConfigDocument, error) {
+	var doc orchestrator.ConfigDocument
+	if err := c.rpcClient.CallContext(ctx, &doc, "admin_getConfig"); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// GetManifest returns the deployments of the genesis the local chains run from
+func (c *Client) GetManifest(ctx context.Context) (*genesis.Manifest, error) {
+	var manifest genesis.Manifest
+	if err := c.rpcClient.CallContext(ctx, &manifest, "admin_getManifest"); err != nil {
+		return nil, err
+	}
+	return &manifest, nil
+}
+
+// GetL2ToL2Message returns the indexed state of an interop message, nil if the message has not been indexed
+func (c *Client) GetL2ToL2Message(ctx context.Context, msgHash common.Hash) (*interop.L2ToL2MessageDocument, error) {
+	var doc *interop.L2ToL2MessageDocument
+	if err := c.rpcClient.CallContext(ctx, &doc, "admin_getL2ToL2Message", msgHash); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// ListL2ToL2Messages returns every indexed interop message, ordered by source chain and nonce
+func (c *Client) ListL2ToL2Messages(ctx context.Context) ([]*interop.L2ToL2MessageDocument, error) {
+	var docs []*interop.L2ToL2MessageDocument
+	if err := c.rpcClient.CallContext(ctx, &docs, "admin_listL2ToL2Messages"); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// ListCrossDomainMessages returns every message sent between the L1 and the L2s through the CrossDomainMessengers,
+// ordered by source chain, destination chain and nonce
+func (c *Client) ListCrossDomainMessages(ctx context.Context) ([]*crossdomain.MessageDocument, error) {
+	var docs []*crossdomain.MessageDocument
+	if err := c.rpcClient.CallContext(ctx, &docs, "admin_listCrossDomainMessages"); err != nil {
+		return nil, err
+	}
+	return docs, nil
+}
+
+// GetCrossDomainMessage returns the indexed state of a message, nil if the message has not been indexed. The L2
+// the message is sent to or from disambiguates identical messages of several L2s, zero matching any
+func (c *Client) GetCrossDomainMessage(ctx context.Context, msgHash common.Hash, l2ChainID uint64) (*crossdomain.MessageDocument, error) {
+	var doc *crossdomain.MessageDocument
+	if err := c.rpcClient.CallContext(ctx, &doc, "admin_getCrossDomainMessage", msgHash, l2ChainID); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
+
+// ReplayCrossDomainMessage relays a failed message again on its destination chain, returning its updated state
+func (c *Client) ReplayCrossDomainMessage(ctx context.Context, msgHash common.Hash, l2ChainID uint64) (*crossdomain.MessageDocument, error) {
+	var doc crossdomain.MessageDocument
+	if err := c.rpcClient.CallContext(ctx, &doc, "admin_replayCrossDomainMessage", msgHash, l2ChainID); err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// PauseSequencer stops the inclusion of user transactions sent to the L2, which are queued or rejected, while
+// deposits are still included
+func (c *Client) PauseSequencer(ctx context.Context, chainID uint64, req *orchestrator.PauseSequencerRequest) error {
+	return c.rpcClient.CallContext(ctx, nil, "admin_pauseSequencer", chainID, req)
+}
+
+// ResumeSequencer includes the transactions queued while paused, unless the sequencing window elapsed
+func (c *Client) ResumeSequencer(ctx context.Context, chainID uint64) (*opsimulator.SequencerResumeResult, error) {
+	var result opsimulator.SequencerResumeResult
+	if err := c.rpcClient.CallContext(ctx, &result, "admin_resumeSequencer", chainID); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// GetSequencerStatus returns the sequencer status of every L2
+func (c *Client) GetSequencerStatus(ctx context.Context) ([]*opsimulator.SequencerStatus, error) {
+	var statuses []*opsimulator.SequencerStatus
+	if err := c.rpcClient.CallContext(ctx, &statuses, "admin_getSequencerStatus"); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// Snapshot stores the state of every chain. Returns the id to revert to
+func (c *Client) Snapshot(ctx context.Context) (uint64, error) {
+	var snapshotID uint64
+	if err := c.rpcClient.CallContext(ctx, &snapshotID, "admin_snapshot"); err != nil {
+		return 0, err
+	}
+	return snapshotID, nil
+}
+
+// Revert restores every chain to the snapshot, discarding it along with any later snapshot
+func (c *Client) Revert(ctx context.Context, snapshotID uint64) error {
+	return c.rpcClient.CallContext(ctx, nil, "admin_revert", snapshotID)
+}
+
+// Fund funds the account on the specified chains, every chain when none are specified
+func (c *Client) Fund(ctx context.Context, account config.FundedAccount, chainIDs []uint64) error {
+	return c.rpcClient.CallContext(ctx, nil, "admin_fund", account, chainIDs)
+}
+
+// SendERC20 bridges a SuperchainERC20 between two L2s, returning the balances once the transfer is relayed
+func (c *Client) SendERC20(ctx context.Context, req *orchestrator.SendERC20Request) (*orchestrator.SendERC20Result, error) {
+	var result orchestrator.SendERC20Result
+	if err := c.rpcClient.CallContext(ctx, &result, "admin_sendERC20", req); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeploySuperchainERC20 deploys the token to the same address on every L2, minting its balances
+func (c *Client) DeploySuperchainERC20(ctx context.Context, token config.Token) (*orchestrator.SuperchainERC20Deployment, error) {
+	var deployment orchestrator.SuperchainERC20Deployment
+	if err := c.rpcClient.CallContext(ctx, &deployment, "admin_deploySuperchainERC20", token); err != nil {
+		return nil, err
+	}
+	return &deployment, nil
+}
+
+// DeployL1Token deploys the token to the L1, minting its balances, along with an OptimismMintableERC20 on every L2
+func (c *Client) DeployL1Token(ctx context.Context, token config.Token) (*orchestrator.L1TokenDeployment, error) {
+	var deployment orchestrator.L1TokenDeployment
+	if err := c.rpcClient.CallContext(ctx, &deployment, "admin_deployL1Token", token); err != nil {
+		return nil, err
+	}
+	return &deployment, nil
+}
+
+// DeployCreate2 deploys the contract through the CREATE2 factory to the same address on every L2, and
+// optionally the L1, returning the address & transaction on every chain
+func (c *Client) DeployCreate2(ctx context.Context, req *orchestrator.DeployCreate2Request) (*orchestrator.DeployCreate2Result, error) {
+	var result orchestrator.DeployCreate2Result
+	if err := c.rpcClient.CallContext(ctx, &result, "admin_deployCreate2", req); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// Refork resets the forked network to the heights of the request, optionally replaying the
+// transactions previously sent to the L2s
+func (c *Client) Refork(ctx context.Context, req *orchestrator.ReforkRequest) (*orchestrator.ReforkResult, error) {
+	var result orchestrator.ReforkResult
+	if err := c.rpcClient.CallContext(ctx, &result, "admin_refork", req); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
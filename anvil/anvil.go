@@ -89,6 +89,9 @@ func (a *Anvil) Start(ctx context.Context) error {
 	if a.cfg.L2Config != nil {
 		args = append(args, "--optimism")
 	}
+	if a.cfg.Hardfork != "" {
+		args = append(args, "--hardfork", a.cfg.Hardfork)
+	}
 	if a.cfg.StartingTimestamp > 0 {
 		args = append(args, "--timestamp", fmt.Sprintf("%d", a.cfg.StartingTimestamp))
 	}
@@ -109,6 +112,9 @@ func (a *Anvil) Start(ctx context.Context) error {
 		args = append(args,
 			"--fork-url", a.cfg.ForkConfig.RPCUrl,
 			"--fork-block-number", fmt.Sprintf("%d", a.cfg.ForkConfig.BlockNumber))
+		if a.cfg.ForkConfig.NoStorageCaching {
+			args = append(args, "--no-storage-caching")
+		}
 	}
 
 	anvilLog := a.log.New("role", "anvil", "name", a.cfg.Name, "chain.id", a.cfg.ChainID)
@@ -284,6 +290,61 @@ func (a *Anvil) SetIntervalMining(ctx context.Context, result interface{}, inter
 	return a.rpcClient.CallContext(ctx, result, "evm_setIntervalMining", interval)
 }
 
+func (a *Anvil) SetAutomine(ctx context.Context, result interface{}, enabled bool) error {
+	return a.rpcClient.CallContext(ctx, result, "evm_setAutomine", enabled)
+}
+
+func (a *Anvil) SetNonce(ctx context.Context, result interface{}, address common.Address, nonce uint64) error {
+	return a.rpcClient.CallContext(ctx, result, "anvil_setNonce", address.Hex(), hexutil.Uint64(nonce))
+}
+
+// Snapshot stores the current chain state. The result is the hex encoded snapshot id
+func (a *Anvil) Snapshot(ctx context.Context, result interface{}) error {
+	return a.rpcClient.CallContext(ctx, result, "evm_snapshot")
+}
+
+// Revert restores the chain state to the given snapshot id. Anvil deletes the
+// snapshot on revert, so a new snapshot must be taken to revert again.
+func (a *Anvil) Revert(ctx context.Context, result interface{}, snapshotID string) error {
+	return a.rpcClient.CallContext(ctx, result, "evm_revert", snapshotID)
+}
+
+// Reset re-forks the chain from the rpc at the specified block number, discarding all local state
+func (a *Anvil) Reset(ctx context.Context, result interface{}, forkURL string, blockNumber uint64) error {
+	forking := map[string]interface{}{"jsonRpcUrl": forkURL, "blockNumber": blockNumber}
+	return a.rpcClient.CallContext(ctx, result, "anvil_reset", map[string]interface{}{"forking": forking})
+}
+
+func (a *Anvil) Mine(ctx context.Context, result interface{}, blocks uint64) error {
+	return a.rpcClient.CallContext(ctx, result, "anvil_mine", hexutil.Uint64(blocks))
+}
+
+func (a *Anvil) SetNextBlockTimestamp(ctx context.Context, result interface{}, timestamp uint64) error {
+	return a.rpcClient.CallContext(ctx, result, "evm_setNextBlockTimestamp", hexutil.Uint64(timestamp))
+}
+
+func (a *Anvil) IncreaseTime(ctx context.Context, result interface{}, seconds uint64) error {
+	return a.rpcClient.CallContext(ctx, result, "evm_increaseTime", hexutil.Uint64(seconds))
+}
+
+// SetBlockTimestampInterval spaces the timestamps of the blocks mined from now on by the interval, rather than the
+// wall clock, until removed
+func (a *Anvil) SetBlockTimestampInterval(ctx context.Context, result interface{}, seconds uint64) error {
+	return a.rpcClient.CallContext(ctx, result, "anvil_setBlockTimestampInterval", seconds)
+}
+
+func (a *Anvil) RemoveBlockTimestampInterval(ctx context.Context, result interface{}) error {
+	return a.rpcClient.CallContext(ctx, result, "anvil_removeBlockTimestampInterval")
+}
+
+func (a *Anvil) ImpersonateAccount(ctx context.Context, result interface{}, address common.Address) error {
+	return a.rpcClient.CallContext(ctx, result, "anvil_impersonateAccount", address.Hex())
+}
+
+func (a *Anvil) StopImpersonatingAccount(ctx context.Context, result interface{}, address common.Address) error {
+	return a.rpcClient.CallContext(ctx, result, "anvil_stopImpersonatingAccount", address.Hex())
+}
+
 // DebugTraceCall internal types
 type txArgs struct {
 	From     common.Address  `json:"from"`
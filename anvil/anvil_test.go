@@ -7,6 +7,8 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	"github.com/ethereum-optimism/supersim/config"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -35,3 +37,62 @@ func TestAnvil(t *testing.T) {
 	require.NoError(t, client.CallContext(context.Background(), &chainId, "eth_chainId"))
 	require.Equal(t, uint64(chainId), cfg.ChainID)
 }
+
+func TestAnvilChainControls(t *testing.T) {
+	cfg := config.ChainConfig{ChainID: 10, Port: 0}
+	testlog := testlog.Logger(t, log.LevelInfo)
+
+	ctx, closeApp := context.WithCancelCause(context.Background())
+	anvil := New(testlog, closeApp, &cfg)
+	t.Cleanup(func() { closeApp(nil) })
+
+	require.NoError(t, anvil.Start(ctx))
+
+	client := anvil.EthClient()
+	account := common.HexToAddress("0x1111111111111111111111111111111111111111")
+
+	// snapshot before any modifications
+	var snapshotID hexutil.Big
+	require.NoError(t, anvil.Snapshot(ctx, &snapshotID))
+
+	// mining
+	require.NoError(t, anvil.Mine(ctx, nil, 5))
+	blockNum, err := client.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), blockNum)
+
+	// timestamps
+	header, err := client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	nextTimestamp := header.Time + 1000
+	require.NoError(t, anvil.SetNextBlockTimestamp(ctx, nil, nextTimestamp))
+	require.NoError(t, anvil.Mine(ctx, nil, 1))
+	header, err = client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, nextTimestamp, header.Time)
+
+	require.NoError(t, anvil.IncreaseTime(ctx, nil, 1000))
+	require.NoError(t, anvil.Mine(ctx, nil, 1))
+	header, err = client.HeaderByNumber(ctx, nil)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, header.Time, nextTimestamp+1000)
+
+	// nonce
+	require.NoError(t, anvil.SetNonce(ctx, nil, account, 10))
+	nonce, err := client.NonceAt(ctx, account, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), nonce)
+
+	// impersonation
+	require.NoError(t, anvil.ImpersonateAccount(ctx, nil, account))
+	require.NoError(t, anvil.StopImpersonatingAccount(ctx, nil, account))
+
+	// revert back to the original snapshot
+	var reverted bool
+	require.NoError(t, anvil.Revert(ctx, &reverted, snapshotID.String()))
+	require.True(t, reverted)
+
+	blockNum, err = client.BlockNumber(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), blockNum)
+}
@@ -16,6 +16,8 @@ func MustParseABI(abiStr string) *abi.ABI {
 
 var SimpleStorageParsedABI = MustParseABI(SimpleStorageMetaData.ABI)
 var L2NativeSuperchainERC20ParseABI = MustParseABI(L2NativeSuperchainERC20MetaData.ABI)
+var MintableSuperchainERC20ParsedABI = MustParseABI(MintableSuperchainERC20MetaData.ABI)
+var MintableERC20ParsedABI = MustParseABI(MintableERC20MetaData.ABI)
 
 var CrossL2InboxParsedABI = MustParseABI(CrossL2InboxMetaData.ABI)
 var L1BlockInteropParsedABI = MustParseABI(L1BlockInteropMetaData.ABI)
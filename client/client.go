@@ -0,0 +1,324 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/supersim/admin"
+	"github.com/ethereum-optimism/supersim/bindings"
+	"github.com/ethereum-optimism/supersim/interop"
+	"github.com/ethereum-optimism/supersim/orchestrator"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const (
+	pollInterval = 250 * time.Millisecond
+
+	// Time allowed to simulate the relay of a message that was not relayed in time
+	simulateTimeout = 5 * time.Second
+)
+
+var sentMessageEventID = bindings.L2ToL2CrossDomainMessengerParsedABI.Events["SentMessage"].ID
+
+// MessageSource returns the indexed state of an interop message, nil if the message has not been indexed.
+// Implemented by the orchestrator & indexer of an embedded instance and by the admin client of a running one
+type MessageSource interface {
+	GetL2ToL2Message(ctx context.Context, msgHash common.Hash) (*interop.L2ToL2MessageDocument, error)
+}
+
+var (
+	_ MessageSource = (*admin.Client)(nil)
+	_ MessageSource = (*orchestrator.Orchestrator)(nil)
+	_ MessageSource = (*interop.L2ToL2MessageIndexer)(nil)
+)
+
+// Client sends & relays messages of the L2ToL2CrossDomainMessenger between the L2s of a supersim instance
+type Client struct {
+	clients  map[uint64]*ethclient.Client
+	messages MessageSource
+
+	// Only set when dialed, closed along with the clients
+	config      *orchestrator.ConfigDocument
+	adminClient *admin.Client
+	l1Client    *ethclient.Client
+	portals     map[uint64]common.Address
+	bridges     map[uint64]common.Address
+}
+
+// SentMessage is an initiated message along with the identifier & payload needed to relay it
+type SentMessage struct {
+	Hash    common.Hash
+	TxHash  common.Hash
+	Message *interop.L2ToL2Message
+
+	Identifier bindings.ICrossL2InboxIdentifier
+	Payload    []byte
+}
+
+// New creates a client over the L2 clients keyed by chain id, waiting on relays with the message source
+func New(clients map[uint64]*ethclient.Client, messages MessageSource) *Client {
+	return &Client{clients: clients, messages: messages}
+}
+
+// Dial connects to the L2s of the supersim instance served by the admin server
+func Dial(ctx context.Context, adminRPC string) (*Client, error) {
+	adminClient, err := admin.NewClient(ctx, adminRPC)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := adminClient.GetConfig(ctx)
+	if err != nil {
+		adminClient.Close()
+		return nil, fmt.Errorf("failed to fetch config: %w", err)
+	}
+
+	c := &Client{clients: make(map[uint64]*ethclient.Client), messages: adminClient, config: doc, adminClient: adminClient, portals: make(map[uint64]common.Address), bridges: make(map[uint64]common.Address)}
+	if c.l1Client, err = ethclient.DialContext(ctx, doc.L1.RPCUrl); err != nil {
+		c.Close()
+		return nil, fmt.Errorf("failed to dial l1: %w", err)
+	}
+
+	for _, l2 := range doc.L2s {
+		if l2.L1Addresses != nil {
+			c.portals[l2.ChainID] = common.Address(l2.L1Addresses.OptimismPortalProxy)
+			c.bridges[l2.ChainID] = common.Address(l2.L1Addresses.L1StandardBridgeProxy)
+		}
+
+		client, err := ethclient.DialContext(ctx, l2.RPCUrl)
+		if err != nil {
+			c.Close()
+			return nil, fmt.Errorf("failed to dial chain %d: %w", l2.ChainID, err)
+		}
+		c.clients[l2.ChainID] = client
+	}
+	return c, nil
+}
+
+// Close closes the connections opened by `Dial`
+func (c *Client) Close() {
+	if c.adminClient == nil {
+		return
+	}
+	for _, client := range c.clients {
+		client.Close()
+	}
+	if c.l1Client != nil {
+		c.l1Client.Close()
+	}
+	c.adminClient.Close()
+}
+
+// Config returns the configuration of the dialed instance, nil if the client was not dialed
+func (c *Client) Config() *orchestrator.ConfigDocument {
+	return c.config
+}
+
+// SendMessage sends the message to the target on the destination chain and waits for the transaction to be
+// included on the source chain
+func (c *Client) SendMessage(ctx context.Context, opts *bind.TransactOpts, source, destination uint64, target common.Address, message []byte) (*SentMessage, error) {
+	client, err := c.ethClient(source)
+	if err != nil {
+		return nil, err
+	}
+
+	messenger, err := bindings.NewL2ToL2CrossDomainMessengerTransactor(predeploys.L2toL2CrossDomainMessengerAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind messenger: %w", err)
+	}
+
+	tx, err := messenger.SendMessage(transactOpts(ctx, opts), new(big.Int).SetUint64(destination), target, message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send message: %w", decodeRPCError(err))
+	}
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for send message transaction: %w", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("send message transaction %s reverted", tx.Hash())
+	}
+
+	for _, log := range receipt.Logs {
+		if log.Address != predeploys.L2toL2CrossDomainMessengerAddr || len(log.Topics) == 0 || log.Topics[0] != sentMessageEventID {
+			continue
+		}
+
+		identifier, err := interop.GetIdentifier(ctx, client, source, log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get message identifier: %w", err)
+		}
+
+		msg, err := interop.NewL2ToL2MessageFromSentMessageEventData(log, identifier)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode SentMessage event: %w", err)
+		}
+
+		msgHash, err := msg.Hash()
+		if err != nil {
+			return nil, err
+		}
+
+		return &SentMessage{
+			Hash:       msgHash,
+			TxHash:     tx.Hash(),
+			Message:    msg,
+			Identifier: *identifier,
+			Payload:    interop.ExecutingMessagePayloadBytes(log),
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no SentMessage event in transaction %s", tx.Hash())
+}
+
+// Message returns the indexed message, such that it can be relayed from its hash alone
+func (c *Client) Message(ctx context.Context, msgHash common.Hash) (*SentMessage, error) {
+	doc, err := c.messages.GetL2ToL2Message(ctx, msgHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch message: %w", err)
+	}
+	if doc == nil {
+		return nil, interop.ErrMessageNotFound
+	}
+	return sentMessageFromDocument(doc), nil
+}
+
+// WaitForRelay waits for the message to be relayed on the destination chain and returns the receipt of the
+// relay. A failed relay is returned as a `*RelayError`. When the context expires before the message is
+// relayed, the relay is simulated to report why it would revert.
+func (c *Client) WaitForRelay(ctx context.Context, msgHash common.Hash) (*types.Receipt, error) {
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	var doc *interop.L2ToL2MessageDocument
+	for {
+		var err error
+		doc, err = c.messages.GetL2ToL2Message(ctx, msgHash)
+		if err != nil && ctx.Err() == nil {
+			return nil, fmt.Errorf("failed to fetch message: %w", err)
+		}
+
+		if doc != nil {
+			switch doc.Status {
+			case interop.Relayed.String():
+				client, err := c.ethClient(doc.Destination)
+				if err != nil {
+					return nil, err
+				}
+				return client.TransactionReceipt(ctx, *doc.RelayedTxHash)
+			case interop.FailedRelay.String():
+				return nil, c.failedRelayError(ctx, sentMessageFromDocument(doc), doc.FailedTxHashes[len(doc.FailedTxHashes)-1])
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if doc == nil {
+				return nil, ctx.Err()
+			}
+
+			simulateCtx, cancel := context.WithTimeout(context.Background(), simulateTimeout)
+			err := c.SimulateRelay(simulateCtx, sentMessageFromDocument(doc))
+			cancel()
+			return nil, errors.Join(ctx.Err(), err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayMessage relays the message on the destination chain, rather than waiting for the auto-relayer.
+// A reverted relay is returned as a `*RelayError`, along with the receipt if the transaction was included.
+func (c *Client) RelayMessage(ctx context.Context, opts *bind.TransactOpts, msg *SentMessage) (*types.Receipt, error) {
+	client, err := c.ethClient(msg.Message.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	messenger, err := bindings.NewL2ToL2CrossDomainMessengerTransactor(predeploys.L2toL2CrossDomainMessengerAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind messenger: %w", err)
+	}
+
+	tx, err := messenger.RelayMessage(transactOpts(ctx, opts), msg.Identifier, msg.Payload)
+	if err != nil {
+		return nil, c.simulatedRelayError(ctx, msg, opts.From, err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for relay transaction: %w", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, c.failedRelayError(ctx, msg, tx.Hash())
+	}
+	return receipt, nil
+}
+
+// SimulateRelay calls the messenger on the destination chain, returning a `*RelayError` if the relay reverts
+func (c *Client) SimulateRelay(ctx context.Context, msg *SentMessage) error {
+	client, err := c.ethClient(msg.Message.Destination)
+	if err != nil {
+		return err
+	}
+
+	call, err := relayCall(common.Address{}, msg)
+	if err != nil {
+		return err
+	}
+	if _, err := client.CallContract(ctx, call, nil); err != nil {
+		return c.simulatedRelayError(ctx, msg, common.Address{}, err)
+	}
+	return nil
+}
+
+func (c *Client) ethClient(chainID uint64) (*ethclient.Client, error) {
+	client, ok := c.clients[chainID]
+	if !ok {
+		return nil, fmt.Errorf("no client for chain %d", chainID)
+	}
+	return client, nil
+}
+
+func relayCall(from common.Address, msg *SentMessage) (ethereum.CallMsg, error) {
+	data, err := bindings.L2ToL2CrossDomainMessengerParsedABI.Pack("relayMessage", msg.Identifier, msg.Payload)
+	if err != nil {
+		return ethereum.CallMsg{}, fmt.Errorf("failed to pack relayMessage: %w", err)
+	}
+	return ethereum.CallMsg{From: from, To: &predeploys.L2toL2CrossDomainMessengerAddr, Data: data}, nil
+}
+
+func sentMessageFromDocument(doc *interop.L2ToL2MessageDocument) *SentMessage {
+	msg := &SentMessage{
+		Hash:   doc.MessageHash,
+		TxHash: doc.SentTxHash,
+		Message: &interop.L2ToL2Message{
+			Destination: doc.Destination,
+			Source:      doc.Source,
+			Nonce:       doc.Nonce.ToInt(),
+			Sender:      doc.Sender,
+			Target:      doc.Target,
+			Message:     doc.Message,
+		},
+		Payload: doc.Payload,
+	}
+	if doc.Identifier != nil {
+		msg.Identifier = *doc.Identifier
+	}
+	return msg
+}
+
+func transactOpts(ctx context.Context, opts *bind.TransactOpts) *bind.TransactOpts {
+	txOpts := *opts
+	txOpts.Context = ctx
+	return &txOpts
+}
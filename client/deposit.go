@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-e2e/bindings"
+	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+const (
+	// Gas limit of the L2 transaction of an ETH deposit
+	depositGasLimit = 100_000
+
+	// Gas limit of the L2StandardBridge call finalizing an ERC20 deposit
+	erc20DepositGasLimit = 200_000
+)
+
+// Deposit is an ETH deposit through the OptimismPortal along with the L2 transaction it was executed in
+type Deposit struct {
+	L1TxHash  common.Hash
+	L2Receipt *types.Receipt
+}
+
+// Deposit sends ETH from the L1 to the recipient on the L2 through its OptimismPortal, and waits for the
+// deposit transaction to be included on the L2. Only available to dialed clients
+func (c *Client) Deposit(ctx context.Context, opts *bind.TransactOpts, chainID uint64, to common.Address, value *big.Int) (*Deposit, error) {
+	portalAddress, ok := c.portals[chainID]
+	if c.l1Client == nil || !ok {
+		return nil, fmt.Errorf("no OptimismPortal known for chain %d", chainID)
+	}
+
+	l2Client, err := c.ethClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	portal, err := opbindings.NewOptimismPortalTransactor(portalAddress, c.l1Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind OptimismPortal: %w", err)
+	}
+
+	txOpts := transactOpts(ctx, opts)
+	txOpts.Value = value
+	tx, err := portal.DepositTransaction(txOpts, to, value, depositGasLimit, false, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deposit: %w", decodeRPCError(err))
+	}
+
+	receipt, err := bind.WaitMined(ctx, c.l1Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for deposit transaction: %w", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("deposit transaction %s reverted", tx.Hash())
+	}
+
+	depositTx, err := depositTxFromReceipt(receipt, portalAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// The L2 transaction is sent by supersim once the deposit event is observed
+	l2Receipt, err := bind.WaitMined(ctx, l2Client, types.NewTx(depositTx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for L2 deposit transaction: %w", err)
+	}
+	return &Deposit{L1TxHash: tx.Hash(), L2Receipt: l2Receipt}, nil
+}
+
+// DepositERC20 approves & deposits the L1 token to the recipient's OptimismMintableERC20 on the L2 through the
+// L1StandardBridge, and waits for the deposit to be finalized on the L2. Only available to dialed clients
+func (c *Client) DepositERC20(ctx context.Context, opts *bind.TransactOpts, chainID uint64, l1Token, l2Token, to common.Address, amount *big.Int) (*Deposit, error) {
+	bridgeAddress, ok := c.bridges[chainID]
+	if c.l1Client == nil || !ok {
+		return nil, fmt.Errorf("no L1StandardBridge known for chain %d", chainID)
+	}
+
+	l2Client, err := c.ethClient(chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := opbindings.NewERC20Transactor(l1Token, c.l1Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind ERC20: %w", err)
+	}
+	tx, err := token.Approve(transactOpts(ctx, opts), bridgeAddress, amount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve the L1StandardBridge: %w", decodeRPCError(err))
+	}
+	if _, err := waitSuccessful(ctx, c.l1Client, tx); err != nil {
+		return nil, fmt.Errorf("failed to approve the L1StandardBridge: %w", err)
+	}
+
+	bridge, err := opbindings.NewL1StandardBridgeTransactor(bridgeAddress, c.l1Client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind L1StandardBridge: %w", err)
+	}
+	tx, err = bridge.DepositERC20To(transactOpts(ctx, opts), l1Token, l2Token, to, amount, erc20DepositGasLimit, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deposit: %w", decodeRPCError(err))
+	}
+	receipt, err := waitSuccessful(ctx, c.l1Client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deposit: %w", err)
+	}
+
+	depositTx, err := depositTxFromReceipt(receipt, c.portals[chainID])
+	if err != nil {
+		return nil, err
+	}
+
+	// The L2 transaction is sent by supersim once the deposit event is observed
+	l2Receipt, err := bind.WaitMined(ctx, l2Client, types.NewTx(depositTx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for L2 deposit transaction: %w", err)
+	}
+	if l2Receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("L2 deposit transaction %s reverted", l2Receipt.TxHash)
+	}
+	return &Deposit{L1TxHash: tx.Hash(), L2Receipt: l2Receipt}, nil
+}
+
+func waitSuccessful(ctx context.Context, client *ethclient.Client, tx *types.Transaction) (*types.Receipt, error) {
+	receipt, err := bind.WaitMined(ctx, client, tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction %s: %w", tx.Hash(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return receipt, nil
+}
+
+func depositTxFromReceipt(receipt *types.Receipt, portalAddress common.Address) (*types.DepositTx, error) {
+	for _, log := range receipt.Logs {
+		if log.Address == portalAddress && len(log.Topics) > 0 && log.Topics[0] == derive.DepositEventABIHash {
+			return derive.UnmarshalDepositLogEvent(log)
+		}
+	}
+	return nil, errors.New("no TransactionDeposited event in deposit transaction")
+}
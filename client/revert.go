@@ -0,0 +1,170 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/supersim/bindings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+var targetCallFailedErrorID = bindings.L2ToL2CrossDomainMessengerParsedABI.Errors["TargetCallFailed"].ID
+
+// RelayError is the decoded revert of a relay
+type RelayError struct {
+	MessageHash common.Hash
+
+	// Revert of the messenger, i.e `MessageAlreadyRelayed()` or `TargetCallFailed()`
+	Reason string
+
+	// Revert of the target, only set when the target call failed and the relay could be traced
+	TargetReason string
+}
+
+func (e *RelayError) Error() string {
+	if e.TargetReason != "" {
+		return fmt.Sprintf("relay of message %s reverted: %s: %s", e.MessageHash, e.Reason, e.TargetReason)
+	}
+	return fmt.Sprintf("relay of message %s reverted: %s", e.MessageHash, e.Reason)
+}
+
+// DecodeRevert returns a readable revert reason: the signature of a messenger error, the reason of a
+// `require` or `assert`, or the hex encoded data when not recognized
+func DecodeRevert(data []byte) string {
+	if len(data) == 0 {
+		return "execution reverted"
+	}
+
+	if len(data) >= 4 {
+		for _, abiErr := range bindings.L2ToL2CrossDomainMessengerParsedABI.Errors {
+			if bytes.Equal(abiErr.ID[:4], data[:4]) {
+				return abiErr.Sig
+			}
+		}
+	}
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason
+	}
+	return hexutil.Encode(data)
+}
+
+// callFrame is the result of the `callTracer`
+type callFrame struct {
+	From   common.Address  `json:"from"`
+	To     *common.Address `json:"to"`
+	Output hexutil.Bytes   `json:"output"`
+	Error  string          `json:"error"`
+	Calls  []callFrame     `json:"calls"`
+}
+
+// failedRelayError traces a reverted relay transaction
+func (c *Client) failedRelayError(ctx context.Context, msg *SentMessage, txHash common.Hash) error {
+	client, err := c.ethClient(msg.Message.Destination)
+	if err != nil {
+		return err
+	}
+
+	relayErr, err := traceRelay(ctx, client, msg, "debug_traceTransaction", txHash)
+	if err != nil {
+		return fmt.Errorf("relay transaction %s reverted, failed to trace: %w", txHash, err)
+	}
+	if relayErr == nil {
+		return fmt.Errorf("relay transaction %s reverted", txHash)
+	}
+	return relayErr
+}
+
+// simulatedRelayError decodes the error of a relay that reverted when called or estimated, tracing the call
+// for the revert of the target. The error data is decoded when the node cannot trace calls.
+func (c *Client) simulatedRelayError(ctx context.Context, msg *SentMessage, from common.Address, callErr error) error {
+	client, err := c.ethClient(msg.Message.Destination)
+	if err != nil {
+		return err
+	}
+
+	call, err := relayCall(from, msg)
+	if err != nil {
+		return err
+	}
+
+	if relayErr, err := traceRelay(ctx, client, msg, "debug_traceCall", toCallArg(call), "latest"); err == nil && relayErr != nil {
+		return relayErr
+	}
+
+	if data, ok := revertData(callErr); ok {
+		return &RelayError{MessageHash: msg.Hash, Reason: DecodeRevert(data)}
+	}
+	return fmt.Errorf("failed to relay message %s: %w", msg.Hash, callErr)
+}
+
+// traceRelay traces a relay with the call tracer. Returns nil if the relay did not revert
+func traceRelay(ctx context.Context, client *ethclient.Client, msg *SentMessage, method string, args ...any) (*RelayError, error) {
+	var frame callFrame
+	args = append(args, map[string]string{"tracer": "callTracer"})
+	if err := client.Client().CallContext(ctx, &frame, method, args...); err != nil {
+		return nil, err
+	}
+	if frame.Error == "" {
+		return nil, nil
+	}
+
+	relayErr := &RelayError{MessageHash: msg.Hash, Reason: DecodeRevert(frame.Output)}
+	if len(frame.Output) >= 4 && bytes.Equal(frame.Output[:4], targetCallFailedErrorID[:4]) {
+		if target := findFailedCall(&frame, predeploys.L2toL2CrossDomainMessengerAddr, msg.Message.Target); target != nil {
+			relayErr.TargetReason = DecodeRevert(target.Output)
+		}
+	}
+	return relayErr, nil
+}
+
+// findFailedCall returns the first failed call from the caller to the target
+func findFailedCall(frame *callFrame, from, to common.Address) *callFrame {
+	if frame.Error != "" && frame.From == from && frame.To != nil && *frame.To == to {
+		return frame
+	}
+	for i := range frame.Calls {
+		if call := findFailedCall(&frame.Calls[i], from, to); call != nil {
+			return call
+		}
+	}
+	return nil
+}
+
+func toCallArg(msg ethereum.CallMsg) map[string]any {
+	return map[string]any{
+		"from": msg.From,
+		"to":   msg.To,
+		"data": hexutil.Bytes(msg.Data),
+	}
+}
+
+// decodeRPCError appends the decoded revert reason to an error carrying revert data
+func decodeRPCError(err error) error {
+	if data, ok := revertData(err); ok {
+		return fmt.Errorf("%w: %s", err, DecodeRevert(data))
+	}
+	return err
+}
+
+// revertData returns the revert data of a call or estimate error
+func revertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if !errors.As(err, &dataErr) {
+		return nil, false
+	}
+	encoded, ok := dataErr.ErrorData().(string)
+	if !ok {
+		return nil, false
+	}
+	data, err := hexutil.Decode(encoded)
+	return data, err == nil
+}
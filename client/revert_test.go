@@ -0,0 +1,55 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/supersim/bindings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRevert(t *testing.T) {
+	alreadyRelayed := bindings.L2ToL2CrossDomainMessengerParsedABI.Errors["MessageAlreadyRelayed"].ID
+	require.Equal(t, "MessageAlreadyRelayed()", DecodeRevert(alreadyRelayed[:4]))
+
+	stringType, _ := abi.NewType("string", "", nil)
+	reason, err := abi.Arguments{{Type: stringType}}.Pack("insufficient balance")
+	require.NoError(t, err)
+	require.Equal(t, "insufficient balance", DecodeRevert(append(common.FromHex("0x08c379a0"), reason...)))
+
+	require.Equal(t, "execution reverted", DecodeRevert(nil))
+	require.Equal(t, "0xdeadbeef", DecodeRevert(common.FromHex("0xdeadbeef")))
+}
+
+func TestFindFailedCall(t *testing.T) {
+	messenger := predeploys.L2toL2CrossDomainMessengerAddr
+	target := common.HexToAddress("0x1234")
+	other := common.HexToAddress("0x5678")
+
+	frame := &callFrame{
+		From:  common.HexToAddress("0x1"),
+		To:    &messenger,
+		Error: "execution reverted",
+		Calls: []callFrame{
+			{From: messenger, To: &other},
+			{From: messenger, To: &target, Error: "execution reverted", Output: hexutil.Bytes{0xde, 0xad}},
+		},
+	}
+
+	call := findFailedCall(frame, messenger, target)
+	require.NotNil(t, call)
+	require.Equal(t, hexutil.Bytes{0xde, 0xad}, call.Output)
+	require.Nil(t, findFailedCall(frame, messenger, other))
+}
+
+func TestRelayErrorMessage(t *testing.T) {
+	err := &RelayError{MessageHash: common.Hash{}, Reason: "TargetCallFailed()", TargetReason: "execution reverted"}
+	require.Contains(t, err.Error(), "TargetCallFailed(): execution reverted")
+
+	err.TargetReason = ""
+	require.NotContains(t, err.Error(), "execution reverted")
+}
@@ -0,0 +1,632 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum-optimism/supersim/admin"
+	superclient "github.com/ethereum-optimism/supersim/client"
+	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/export"
+	"github.com/ethereum-optimism/supersim/interop"
+	"github.com/ethereum-optimism/supersim/orchestrator"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	TimeCommandName        = "time"
+	ReforkCommandName      = "refork"
+	ExportCommandName      = "export"
+	StatusCommandName      = "status"
+	ChainsCommandName      = "chains"
+	MessagesCommandName    = "messages"
+	CrossDomainCommandName = "crossdomain"
+	SequencerCommandName   = "sequencer"
+	RelayCommandName       = "relay"
+	DepositCommandName     = "deposit"
+	SnapshotCommandName    = "snapshot"
+	RevertCommandName      = "revert"
+	FundCommandName        = "fund"
+	BridgeCommandName      = "bridge"
+	DeployCommandName      = "deploy"
+)
+
+func TimeAdvanceMain(ctx *cli.Context) error {
+	seconds, err := uint64Arg(ctx, 0, "seconds")
+	if err != nil {
+		return err
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	timestamp, err := client.AdvanceTime(ctx.Context, seconds)
+	if err != nil {
+		return fmt.Errorf("failed to advance time: %w", err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "advanced time by %d seconds. timestamp: %d\n", seconds, timestamp)
+	return nil
+}
+
+func TimeSetMain(ctx *cli.Context) error {
+	timestamp, err := uint64Arg(ctx, 0, "timestamp")
+	if err != nil {
+		return err
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.SetTime(ctx.Context, timestamp); err != nil {
+		return fmt.Errorf("failed to set time: %w", err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "set time. timestamp: %d\n", timestamp)
+	return nil
+}
+
+func ReforkMain(ctx *cli.Context) error {
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	l2BlockNumbers, err := config.ReadChainForkHeights(ctx)
+	if err != nil {
+		return err
+	}
+
+	req := &orchestrator.ReforkRequest{
+		L1BlockNumber:  ctx.Uint64(config.L1ForkHeightFlagName),
+		Timestamp:      ctx.Uint64(config.ForkTimestampFlagName),
+		L2BlockNumbers: l2BlockNumbers,
+		ReplayTxs:      ctx.Bool(config.ReplayTxsFlagName),
+	}
+	result, err := client.Refork(ctx.Context, req)
+	if err != nil {
+		return fmt.Errorf("failed to refork: %w", err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "re-forked l1 at height %d\n", result.L1BlockNumber)
+	for chainID, height := range result.L2BlockNumbers {
+		fmt.Fprintf(ctx.App.Writer, "re-forked l2 %d at height %d\n", chainID, height)
+	}
+	if ctx.Bool(config.ReplayTxsFlagName) {
+		fmt.Fprintf(ctx.App.Writer, "replayed %d transactions, %d failed\n", result.ReplayedTransactions, result.FailedTransactions)
+	}
+	return nil
+}
+
+func ExportMain(ctx *cli.Context) error {
+	format := export.Format(ctx.String(config.ExportFormatFlagName))
+	if !format.IsValid() {
+		return fmt.Errorf("unrecognized export format `%s`, available formats: %v", format, export.Formats)
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	doc, err := client.GetConfig(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	var b bytes.Buffer
+	if err := export.Write(&b, doc, format); err != nil {
+		return fmt.Errorf("failed to export config: %w", err)
+	}
+
+	out := ctx.String(config.ExportOutFlagName)
+	if out == "" {
+		_, err := ctx.App.Writer.Write(b.Bytes())
+		return err
+	}
+	if err := os.WriteFile(out, b.Bytes(), 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", out, err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "exported %s config to %s\n", format, out)
+	return nil
+}
+
+func StatusMain(ctx *cli.Context) error {
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	doc, err := client.GetConfig(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "admin rpc: %s\n", doc.AdminRPC)
+	if doc.Interop != nil {
+		fmt.Fprintf(ctx.App.Writer, "interop: enabled, autorelay: %t\n", doc.Interop.AutoRelay)
+	} else {
+		fmt.Fprintln(ctx.App.Writer, "interop: disabled")
+	}
+	fmt.Fprintln(ctx.App.Writer)
+
+	w := tabwriter.NewWriter(ctx.App.Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCHAIN ID\tBLOCK\tTIMESTAMP\tRPC")
+	for _, chain := range append([]*orchestrator.ChainDocument{doc.L1}, doc.L2s...) {
+		header, err := latestHeader(ctx.Context, chain.RPCUrl)
+		if err != nil {
+			fmt.Fprintf(w, "%s\t%d\t-\t-\t%s (%v)\n", chain.Name, chain.ChainID, chain.RPCUrl, err)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%s\n", chain.Name, chain.ChainID, header.Number, header.Time, chain.RPCUrl)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if doc.Interop == nil {
+		return nil
+	}
+
+	messages, err := client.ListL2ToL2Messages(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	counts := make(map[string]int)
+	for _, msg := range messages {
+		counts[msg.Status]++
+	}
+	fmt.Fprintf(ctx.App.Writer, "\nmessages: %d total, %d sent, %d relayed, %d failed\n", len(messages), counts[interop.Sent.String()], counts[interop.Relayed.String()], counts[interop.FailedRelay.String()])
+	return nil
+}
+
+func ChainsMain(ctx *cli.Context) error {
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	doc, err := client.GetConfig(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	w := tabwriter.NewWriter(ctx.App.Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCHAIN ID\tRPC\tFORK BLOCK\tLOG PATH")
+	for _, chain := range append([]*orchestrator.ChainDocument{doc.L1}, doc.L2s...) {
+		forkBlock := "-"
+		if chain.ForkBlockNumber > 0 {
+			forkBlock = strconv.FormatUint(chain.ForkBlockNumber, 10)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", chain.Name, chain.ChainID, chain.RPCUrl, forkBlock, chain.LogPath)
+	}
+	return w.Flush()
+}
+
+func DepositMain(ctx *cli.Context) error {
+	c, err := superclient.Dial(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	doc := c.Config()
+	chainID, err := resolveChainID(doc, ctx.String(config.ChainFlagName))
+	if err != nil {
+		return err
+	}
+
+	var l1Token *orchestrator.L1TokenDeployment
+	decimals := uint8(18)
+	if token := ctx.String(config.TokenFlagName); token != "" {
+		if l1Token, err = resolveL1Token(doc, token); err != nil {
+			return err
+		}
+		decimals = l1Token.Decimals
+	}
+
+	amount, err := config.ParseAmount(ctx.String(config.AmountFlagName), decimals)
+	if err != nil {
+		return err
+	}
+
+	opts, err := transactOpts(ctx, doc, doc.L1.ChainID)
+	if err != nil {
+		return err
+	}
+
+	to := opts.From
+	if recipient := ctx.String(config.RecipientFlagName); recipient != "" {
+		if !common.IsHexAddress(recipient) {
+			return fmt.Errorf("invalid --%s address `%s`", config.RecipientFlagName, recipient)
+		}
+		to = common.HexToAddress(recipient)
+	}
+
+	if l1Token != nil {
+		l2Token, ok := l1Token.L2Token(chainID)
+		if !ok {
+			return fmt.Errorf("no OptimismMintableERC20 of %s on chain %d", l1Token.Symbol, chainID)
+		}
+
+		deposit, err := c.DepositERC20(ctx.Context, opts, chainID, l1Token.Address, l2Token, to, amount)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(ctx.App.Writer, "deposited %s %s to %s on chain %d, l2 token: %s\n", ctx.String(config.AmountFlagName), l1Token.Symbol, to, chainID, l2Token)
+		fmt.Fprintf(ctx.App.Writer, "l1 tx: %s\nl2 tx: %s\n", deposit.L1TxHash, deposit.L2Receipt.TxHash)
+		return nil
+	}
+
+	deposit, err := c.Deposit(ctx.Context, opts, chainID, to, amount)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "deposited %s ETH to %s on chain %d\n", ctx.String(config.AmountFlagName), to, chainID)
+	fmt.Fprintf(ctx.App.Writer, "l1 tx: %s\nl2 tx: %s\n", deposit.L1TxHash, deposit.L2Receipt.TxHash)
+	return nil
+}
+
+func SnapshotMain(ctx *cli.Context) error {
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	snapshotID, err := client.Snapshot(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to snapshot: %w", err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "snapshot id: %d\n", snapshotID)
+	return nil
+}
+
+func RevertMain(ctx *cli.Context) error {
+	snapshotID, err := uint64Arg(ctx, 0, "snapshot-id")
+	if err != nil {
+		return err
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Revert(ctx.Context, snapshotID); err != nil {
+		return fmt.Errorf("failed to revert: %w", err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "reverted to snapshot %d\n", snapshotID)
+	return nil
+}
+
+func FundMain(ctx *cli.Context) error {
+	address, err := addressArg(ctx, 0, "address")
+	if err != nil {
+		return err
+	}
+
+	account := config.FundedAccount{Address: address, Amount: ctx.String(config.AmountFlagName)}
+	if token := ctx.String(config.TokenFlagName); token != "" {
+		if !common.IsHexAddress(token) {
+			return fmt.Errorf("invalid --%s address `%s`", config.TokenFlagName, token)
+		}
+		tokenAddr := common.HexToAddress(token)
+		account.Token = &tokenAddr
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var chainIDs []uint64
+	if chains := ctx.StringSlice(config.ChainFlagName); len(chains) > 0 {
+		doc, err := client.GetConfig(ctx.Context)
+		if err != nil {
+			return fmt.Errorf("failed to get config: %w", err)
+		}
+		for _, chain := range chains {
+			chainID, err := resolveChainID(doc, chain)
+			if err != nil {
+				return err
+			}
+			chainIDs = append(chainIDs, chainID)
+		}
+	}
+
+	if err := client.Fund(ctx.Context, account, chainIDs); err != nil {
+		return fmt.Errorf("failed to fund: %w", err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "funded %s with %s %s\n", address, account.Amount, fundedAsset(account))
+	return nil
+}
+
+func BridgeMain(ctx *cli.Context) error {
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	doc, err := client.GetConfig(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get config: %w", err)
+	}
+
+	token, err := resolveToken(doc, ctx.String(config.TokenFlagName))
+	if err != nil {
+		return err
+	}
+
+	req := &orchestrator.SendERC20Request{Token: token, Amount: ctx.String(config.AmountFlagName)}
+	if req.Source, err = resolveChainID(doc, ctx.String(config.SourceFlagName)); err != nil {
+		return err
+	}
+	if req.Destination, err = resolveChainID(doc, ctx.String(config.DestinationFlagName)); err != nil {
+		return err
+	}
+
+	if from := ctx.String(config.SenderFlagName); from != "" {
+		if !common.IsHexAddress(from) {
+			return fmt.Errorf("invalid --%s address `%s`", config.SenderFlagName, from)
+		}
+		req.From = common.HexToAddress(from)
+	} else if len(doc.Accounts) > 0 {
+		req.From = doc.Accounts[0].Address
+	} else {
+		return fmt.Errorf("no dev accounts, --%s must be specified", config.SenderFlagName)
+	}
+
+	if recipient := ctx.String(config.RecipientFlagName); recipient != "" {
+		if !common.IsHexAddress(recipient) {
+			return fmt.Errorf("invalid --%s address `%s`", config.RecipientFlagName, recipient)
+		}
+		to := common.HexToAddress(recipient)
+		req.To = &to
+	}
+
+	result, err := client.SendERC20(ctx.Context, req)
+	if err != nil {
+		return fmt.Errorf("failed to send ERC20: %w", err)
+	}
+
+	to := req.From
+	if req.To != nil {
+		to = *req.To
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "sent %s of %s from chain %d to chain %d\n", req.Amount, token, req.Source, req.Destination)
+	fmt.Fprintf(ctx.App.Writer, "message: %s\nsend tx: %s\nrelay tx: %s\n", result.MessageHash, result.SendTxHash, result.RelayTxHash)
+	fmt.Fprintf(ctx.App.Writer, "balance of %s on chain %d: %s\n", req.From, req.Source, config.FormatAmount(result.SourceBalance.ToInt(), result.Decimals))
+	fmt.Fprintf(ctx.App.Writer, "balance of %s on chain %d: %s\n", to, req.Destination, config.FormatAmount(result.DestinationBalance.ToInt(), result.Decimals))
+	return nil
+}
+
+func DeployMain(ctx *cli.Context) error {
+	initCode, err := initCode(ctx)
+	if err != nil {
+		return err
+	}
+
+	salt, err := hexutil.Decode(ctx.String(config.SaltFlagName))
+	if err != nil || len(salt) > common.HashLength {
+		return fmt.Errorf("invalid --%s `%s`, expected up to 32 hex encoded bytes", config.SaltFlagName, ctx.String(config.SaltFlagName))
+	}
+
+	req := &orchestrator.DeployCreate2Request{InitCode: initCode, Salt: common.BytesToHash(salt), IncludeL1: ctx.Bool(config.DeployL1FlagName)}
+	if from := ctx.String(config.SenderFlagName); from != "" {
+		if !common.IsHexAddress(from) {
+			return fmt.Errorf("invalid --%s address `%s`", config.SenderFlagName, from)
+		}
+		sender := common.HexToAddress(from)
+		req.From = &sender
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	result, err := client.DeployCreate2(ctx.Context, req)
+	if err != nil {
+		return fmt.Errorf("failed to deploy: %w", err)
+	}
+
+	alreadyDeployed := true
+	for _, deployment := range result.Deployments {
+		alreadyDeployed = alreadyDeployed && deployment.AlreadyDeployed
+	}
+	if alreadyDeployed {
+		fmt.Fprintf(ctx.App.Writer, "already deployed at %s\n\n", result.Address)
+	} else {
+		fmt.Fprintf(ctx.App.Writer, "deployed at %s\n\n", result.Address)
+	}
+
+	w := tabwriter.NewWriter(ctx.App.Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHAIN ID\tADDRESS\tTX")
+	for _, deployment := range result.Deployments {
+		txHash := "already deployed"
+		if !deployment.AlreadyDeployed {
+			txHash = deployment.TxHash.Hex()
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\n", deployment.ChainID, deployment.Address, txHash)
+	}
+	return w.Flush()
+}
+
+// initCode reads the creation bytecode from --bytecode or the foundry --artifact, appending the constructor arguments
+func initCode(ctx *cli.Context) ([]byte, error) {
+	bytecode, artifact := ctx.String(config.BytecodeFlagName), ctx.String(config.ArtifactFlagName)
+	if (bytecode == "") == (artifact == "") {
+		return nil, fmt.Errorf("exactly one of --%s or --%s is required", config.BytecodeFlagName, config.ArtifactFlagName)
+	}
+
+	if artifact != "" {
+		data, err := os.ReadFile(artifact)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read artifact: %w", err)
+		}
+
+		var foundryArtifact struct {
+			Bytecode struct {
+				Object string `json:"object"`
+			} `json:"bytecode"`
+		}
+		if err := json.Unmarshal(data, &foundryArtifact); err != nil {
+			return nil, fmt.Errorf("failed to decode artifact %s: %w", artifact, err)
+		}
+		if strings.Contains(foundryArtifact.Bytecode.Object, "__$") {
+			return nil, fmt.Errorf("artifact %s has unlinked libraries", artifact)
+		}
+		bytecode = foundryArtifact.Bytecode.Object
+	}
+
+	code, err := hexutil.Decode(bytecode)
+	if err != nil || len(code) == 0 {
+		return nil, fmt.Errorf("invalid creation bytecode `%s`", bytecode)
+	}
+
+	if args := ctx.String(config.ConstructorArgsFlagName); args != "" {
+		encoded, err := hexutil.Decode(args)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", config.ConstructorArgsFlagName, err)
+		}
+		code = append(code, encoded...)
+	}
+	return code, nil
+}
+
+// resolveToken matches an interop predeploy by name or a deployed token by name or symbol, case
+// insensitive, or parses the address
+func resolveToken(doc *orchestrator.ConfigDocument, token string) (common.Address, error) {
+	if common.IsHexAddress(token) {
+		return common.HexToAddress(token), nil
+	}
+	if doc.Interop != nil {
+		for name, addr := range doc.Interop.Predeploys {
+			if strings.EqualFold(name, token) {
+				return addr, nil
+			}
+		}
+		for _, deployment := range doc.Interop.Tokens {
+			if strings.EqualFold(deployment.Name, token) || strings.EqualFold(deployment.Symbol, token) {
+				return deployment.Address, nil
+			}
+		}
+	}
+	return common.Address{}, fmt.Errorf("unknown token `%s`", token)
+}
+
+// resolveL1Token matches an L1 token by address, or by name or symbol, case insensitive
+func resolveL1Token(doc *orchestrator.ConfigDocument, token string) (*orchestrator.L1TokenDeployment, error) {
+	for _, deployment := range doc.L1Tokens {
+		if strings.EqualFold(deployment.Address.Hex(), token) || strings.EqualFold(deployment.Name, token) || strings.EqualFold(deployment.Symbol, token) {
+			return deployment, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown L1 token `%s`", token)
+}
+
+func fundedAsset(account config.FundedAccount) string {
+	if account.Token == nil {
+		return "ETH"
+	}
+	return account.Token.Hex()
+}
+
+// resolveChainID matches a chain by name, case insensitive, or by id
+func resolveChainID(doc *orchestrator.ConfigDocument, chain string) (uint64, error) {
+	for _, c := range append([]*orchestrator.ChainDocument{doc.L1}, doc.L2s...) {
+		if strings.EqualFold(c.Name, chain) || strconv.FormatUint(c.ChainID, 10) == chain {
+			return c.ChainID, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown chain `%s`", chain)
+}
+
+// transactOpts signs with the --private.key, or the first dev account of the running instance
+func transactOpts(ctx *cli.Context, doc *orchestrator.ConfigDocument, chainID uint64) (*bind.TransactOpts, error) {
+	privateKeyHex := ctx.String(config.PrivateKeyFlagName)
+	if privateKeyHex == "" {
+		if len(doc.Accounts) == 0 {
+			return nil, fmt.Errorf("no dev accounts, --%s is required", config.PrivateKeyFlagName)
+		}
+		privateKeyHex = doc.Accounts[0].PrivateKey
+	}
+
+	privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(privateKeyHex, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid --%s: %w", config.PrivateKeyFlagName, err)
+	}
+	return bind.NewKeyedTransactorWithChainID(privateKey, new(big.Int).SetUint64(chainID))
+}
+
+func latestHeader(ctx context.Context, rpcURL string) (*types.Header, error) {
+	client, err := ethclient.DialContext(ctx, rpcURL)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	return client.HeaderByNumber(ctx, nil)
+}
+
+func addressArg(ctx *cli.Context, index int, name string) (common.Address, error) {
+	if ctx.Args().Len() <= index {
+		return common.Address{}, fmt.Errorf("missing required argument <%s>", name)
+	}
+
+	value := ctx.Args().Get(index)
+	if !common.IsHexAddress(value) {
+		return common.Address{}, fmt.Errorf("invalid <%s> argument `%s`", name, value)
+	}
+	return common.HexToAddress(value), nil
+}
+
+func uint64Arg(ctx *cli.Context, index int, name string) (uint64, error) {
+	if ctx.Args().Len() <= index {
+		return 0, fmt.Errorf("missing required argument <%s>", name)
+	}
+
+	value, err := strconv.ParseUint(ctx.Args().Get(index), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid <%s> argument: %w", name, err)
+	}
+	return value, nil
+}
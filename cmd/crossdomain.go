@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/ethereum-optimism/supersim/admin"
+	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/crossdomain"
+
+	"github.com/urfave/cli/v2"
+)
+
+func CrossDomainListMain(ctx *cli.Context) error {
+	status := ctx.String(config.MessageStatusFlagName)
+	switch status {
+	case "", crossdomain.Sent.String(), crossdomain.Relayed.String(), crossdomain.FailedRelay.String(), crossdomain.Replayed.String():
+	default:
+		return fmt.Errorf("unrecognized message status `%s`", status)
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	messages, err := client.ListCrossDomainMessages(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	w := tabwriter.NewWriter(ctx.App.Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HASH\tDIRECTION\tSOURCE\tDESTINATION\tNONCE\tTARGET\tSTATUS")
+	for _, msg := range messages {
+		if status != "" && msg.Status != status {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\n", msg.MessageHash, msg.Direction, msg.Source, msg.Destination, msg.Nonce.ToInt(), msg.Target, msg.Status)
+	}
+	return w.Flush()
+}
+
+func CrossDomainShowMain(ctx *cli.Context) error {
+	msgHash, err := hashArg(ctx, 0, "message-hash")
+	if err != nil {
+		return err
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	l2ChainID, err := crossDomainChain(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	msg, err := client.GetCrossDomainMessage(ctx.Context, msgHash, l2ChainID)
+	if err != nil {
+		return fmt.Errorf("failed to get message: %w", err)
+	}
+	if msg == nil {
+		return fmt.Errorf("message %s not found", msgHash)
+	}
+
+	enc := json.NewEncoder(ctx.App.Writer)
+	enc.SetIndent("", "  ")
+	return enc.Encode(msg)
+}
+
+func CrossDomainReplayMain(ctx *cli.Context) error {
+	msgHash, err := hashArg(ctx, 0, "message-hash")
+	if err != nil {
+		return err
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	l2ChainID, err := crossDomainChain(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	msg, err := client.ReplayCrossDomainMessage(ctx.Context, msgHash, l2ChainID)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "replayed message %s on chain %d. tx: %s\n", msgHash, msg.Destination, msg.RelayedTxHash)
+	return nil
+}
+
+// crossDomainChain resolves the optional --chain, zero matching any chain
+func crossDomainChain(ctx *cli.Context, client *admin.Client) (uint64, error) {
+	chain := ctx.String(config.ChainFlagName)
+	if chain == "" {
+		return 0, nil
+	}
+
+	doc, err := client.GetConfig(ctx.Context)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get config: %w", err)
+	}
+	return resolveChainID(doc, chain)
+}
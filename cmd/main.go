@@ -55,8 +55,7 @@ func main() {
 
 	baseFlags := append(config.BaseCLIFlags(envVarPrefix), logFlags...)
 
-	// Vanilla mode has no specific flags for now
-	app.Flags = baseFlags
+	app.Flags = append(config.GenesisCLIFlags(envVarPrefix), baseFlags...)
 
 	// Subcommands
 	app.Commands = []*cli.Command{
@@ -66,6 +65,164 @@ func main() {
 			Flags:  append(config.ForkCLIFlags(envVarPrefix), baseFlags...),
 			Action: cliapp.LifecycleCmd(SupersimMain),
 		},
+		{
+			Name:  TimeCommandName,
+			Usage: "Move time forward on every chain of a running instance",
+			Subcommands: []*cli.Command{
+				{
+					Name:      "advance",
+					Usage:     "Advance the L1 and all L2s by the specified number of seconds",
+					ArgsUsage: "<seconds>",
+					Flags:     config.AdminClientCLIFlags(envVarPrefix),
+					Action:    TimeAdvanceMain,
+				},
+				{
+					Name:      "set",
+					Usage:     "Set the L1 and all L2s to the specified unix timestamp",
+					ArgsUsage: "<timestamp>",
+					Flags:     config.AdminClientCLIFlags(envVarPrefix),
+					Action:    TimeSetMain,
+				},
+			},
+		},
+		{
+			Name:   ReforkCommandName,
+			Usage:  "Re-fork the L1 and forked L2s of a running instance to a newer height",
+			Flags:  config.ReforkCLIFlags(envVarPrefix),
+			Action: ReforkMain,
+		},
+		{
+			Name:   StatusCommandName,
+			Usage:  "Show the chains of a running instance with their latest block, and the interop message counts",
+			Flags:  config.AdminClientCLIFlags(envVarPrefix),
+			Action: StatusMain,
+		},
+		{
+			Name:   ChainsCommandName,
+			Usage:  "List the chains of a running instance with their rpc urls",
+			Flags:  config.AdminClientCLIFlags(envVarPrefix),
+			Action: ChainsMain,
+		},
+		{
+			Name:  MessagesCommandName,
+			Usage: "Inspect the interop messages indexed by a running instance",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the messages sent through the L2ToL2CrossDomainMessenger",
+					Flags:  config.MessagesCLIFlags(envVarPrefix),
+					Action: MessagesListMain,
+				},
+				{
+					Name:      "show",
+					Usage:     "Show a message, its status, identifier & payload",
+					ArgsUsage: "<message-hash>",
+					Flags:     config.AdminClientCLIFlags(envVarPrefix),
+					Action:    MessagesShowMain,
+				},
+			},
+		},
+		{
+			Name:  CrossDomainCommandName,
+			Usage: "Inspect & replay the messages sent between the L1 and the L2s through the CrossDomainMessengers",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "list",
+					Usage:  "List the messages sent through the L1CrossDomainMessenger & L2CrossDomainMessenger",
+					Flags:  config.CrossDomainMessagesCLIFlags(envVarPrefix),
+					Action: CrossDomainListMain,
+				},
+				{
+					Name:      "show",
+					Usage:     "Show a message, its status & relay transactions",
+					ArgsUsage: "<message-hash>",
+					Flags:     config.CrossDomainMessageCLIFlags(envVarPrefix),
+					Action:    CrossDomainShowMain,
+				},
+				{
+					Name:      "replay",
+					Usage:     "Relay a failed message again on its destination chain",
+					ArgsUsage: "<message-hash>",
+					Flags:     config.CrossDomainMessageCLIFlags(envVarPrefix),
+					Action:    CrossDomainReplayMain,
+				},
+			},
+		},
+		{
+			Name:  SequencerCommandName,
+			Usage: "Pause & resume the sequencer of an L2 to force transactions through the OptimismPortal",
+			Subcommands: []*cli.Command{
+				{
+					Name:   "pause",
+					Usage:  "Stop including user transactions, deposits from the L1 are still included",
+					Flags:  config.SequencerPauseCLIFlags(envVarPrefix),
+					Action: SequencerPauseMain,
+				},
+				{
+					Name:   "resume",
+					Usage:  "Include the transactions queued while paused, unless the sequencing window elapsed",
+					Flags:  config.SequencerResumeCLIFlags(envVarPrefix),
+					Action: SequencerResumeMain,
+				},
+				{
+					Name:   "status",
+					Usage:  "List the sequencer status of every L2",
+					Flags:  config.AdminClientCLIFlags(envVarPrefix),
+					Action: SequencerStatusMain,
+				},
+			},
+		},
+		{
+			Name:      RelayCommandName,
+			Usage:     "Relay an interop message on its destination chain",
+			ArgsUsage: "<message-hash>",
+			Flags:     config.RelayCLIFlags(envVarPrefix),
+			Action:    RelayMain,
+		},
+		{
+			Name:   DepositCommandName,
+			Usage:  "Deposit ETH from the L1 to an L2 through the OptimismPortal",
+			Flags:  config.DepositCLIFlags(envVarPrefix),
+			Action: DepositMain,
+		},
+		{
+			Name:   SnapshotCommandName,
+			Usage:  "Snapshot the state of every chain of a running instance",
+			Flags:  config.AdminClientCLIFlags(envVarPrefix),
+			Action: SnapshotMain,
+		},
+		{
+			Name:      RevertCommandName,
+			Usage:     "Revert every chain of a running instance to a snapshot",
+			ArgsUsage: "<snapshot-id>",
+			Flags:     config.AdminClientCLIFlags(envVarPrefix),
+			Action:    RevertMain,
+		},
+		{
+			Name:      FundCommandName,
+			Usage:     "Set the ETH or ERC20 balance of an address on the chains of a running instance",
+			ArgsUsage: "<address>",
+			Flags:     config.FundCLIFlags(envVarPrefix),
+			Action:    FundMain,
+		},
+		{
+			Name:   BridgeCommandName,
+			Usage:  "Send a SuperchainERC20 between the L2s of a running instance and wait for the relay",
+			Flags:  config.BridgeCLIFlags(envVarPrefix),
+			Action: BridgeMain,
+		},
+		{
+			Name:   DeployCommandName,
+			Usage:  "Deploy a contract through the CREATE2 factory to the same address on every L2, and optionally the L1",
+			Flags:  config.DeployCLIFlags(envVarPrefix),
+			Action: DeployMain,
+		},
+		{
+			Name:   ExportCommandName,
+			Usage:  "Export the rpc urls, chain ids & contract addresses of a running instance for foundry, hardhat or a .env file",
+			Flags:  config.ExportCLIFlags(envVarPrefix),
+			Action: ExportMain,
+		},
 	}
 
 	ctx := ctxinterrupt.WithSignalWaiterMain(context.Background())
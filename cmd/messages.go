@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/ethereum-optimism/supersim/admin"
+	superclient "github.com/ethereum-optimism/supersim/client"
+	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/interop"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/urfave/cli/v2"
+)
+
+func MessagesListMain(ctx *cli.Context) error {
+	status := ctx.String(config.MessageStatusFlagName)
+	switch status {
+	case "", interop.Sent.String(), interop.Relayed.String(), interop.FailedRelay.String():
+	default:
+		return fmt.Errorf("unrecognized message status `%s`", status)
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	messages, err := client.ListL2ToL2Messages(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to list messages: %w", err)
+	}
+
+	w := tabwriter.NewWriter(ctx.App.Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "HASH\tSOURCE\tDESTINATION\tNONCE\tTARGET\tSTATUS")
+	for _, msg := range messages {
+		if status != "" && msg.Status != status {
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%s\t%s\t%s\n", msg.MessageHash, msg.Source, msg.Destination, msg.Nonce.ToInt(), msg.Target, msg.Status)
+	}
+	return w.Flush()
+}
+
+func MessagesShowMain(ctx *cli.Context) error {
+	msgHash, err := hashArg(ctx, 0, "message-hash")
+	if err != nil {
+		return err
+	}
+
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	msg, err := client.GetL2ToL2Message(ctx.Context, msgHash)
+	if err != nil {
+		return fmt.Errorf("failed to get message: %w", err)
+	}
+	if msg == nil {
+		return fmt.Errorf("message %s not found", msgHash)
+	}
+
+	enc := json.NewEncoder(ctx.App.Writer)
+	enc.SetIndent("", "  ")
+	return enc.Encode(msg)
+}
+
+func RelayMain(ctx *cli.Context) error {
+	msgHash, err := hashArg(ctx, 0, "message-hash")
+	if err != nil {
+		return err
+	}
+
+	c, err := superclient.Dial(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer c.Close()
+
+	msg, err := c.Message(ctx.Context, msgHash)
+	if errors.Is(err, interop.ErrMessageNotFound) {
+		return fmt.Errorf("message %s not found", msgHash)
+	}
+	if err != nil {
+		return err
+	}
+
+	opts, err := transactOpts(ctx, c.Config(), msg.Message.Destination)
+	if err != nil {
+		return err
+	}
+
+	receipt, err := c.RelayMessage(ctx.Context, opts, msg)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "relayed message %s on chain %d. tx: %s\n", msgHash, msg.Message.Destination, receipt.TxHash)
+	return nil
+}
+
+func hashArg(ctx *cli.Context, index int, name string) (common.Hash, error) {
+	if ctx.Args().Len() <= index {
+		return common.Hash{}, fmt.Errorf("missing required argument <%s>", name)
+	}
+
+	value := ctx.Args().Get(index)
+	hash := common.HexToHash(value)
+	if len(common.FromHex(value)) != common.HashLength {
+		return common.Hash{}, fmt.Errorf("invalid <%s> argument `%s`", name, value)
+	}
+	return hash, nil
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"text/tabwriter"
+
+	"github.com/ethereum-optimism/supersim/admin"
+	"github.com/ethereum-optimism/supersim/config"
+	opsimulator "github.com/ethereum-optimism/supersim/opsimulator"
+	"github.com/ethereum-optimism/supersim/orchestrator"
+
+	"github.com/urfave/cli/v2"
+)
+
+func SequencerPauseMain(ctx *cli.Context) error {
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	chainID, err := sequencerChainID(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	req := &orchestrator.PauseSequencerRequest{
+		Mode:             opsimulator.SequencerMode(ctx.String(config.SequencerModeFlagName)),
+		SequencingWindow: ctx.Uint64(config.SequencingWindowFlagName),
+	}
+	if err := client.PauseSequencer(ctx.Context, chainID, req); err != nil {
+		return fmt.Errorf("failed to pause the sequencer: %w", err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "paused the sequencer of chain %d in %s mode\n", chainID, req.Mode)
+	return nil
+}
+
+func SequencerResumeMain(ctx *cli.Context) error {
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	chainID, err := sequencerChainID(ctx, client)
+	if err != nil {
+		return err
+	}
+
+	result, err := client.ResumeSequencer(ctx.Context, chainID)
+	if err != nil {
+		return fmt.Errorf("failed to resume the sequencer: %w", err)
+	}
+
+	fmt.Fprintf(ctx.App.Writer, "resumed the sequencer of chain %d, %d queued transactions included, %d dropped\n", chainID, len(result.IncludedTxs), len(result.DroppedTxs))
+	if result.WindowElapsed {
+		fmt.Fprintln(ctx.App.Writer, "the sequencing window elapsed while paused, queued transactions were dropped")
+	}
+	for _, txHash := range result.DroppedTxs {
+		fmt.Fprintf(ctx.App.Writer, "dropped: %s\n", txHash)
+	}
+	return nil
+}
+
+func SequencerStatusMain(ctx *cli.Context) error {
+	client, err := admin.NewClient(ctx.Context, ctx.String(config.AdminRPCFlagName))
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	statuses, err := client.GetSequencerStatus(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to get the sequencer status: %w", err)
+	}
+
+	w := tabwriter.NewWriter(ctx.App.Writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "CHAIN ID\tSEQUENCER\tMODE\tPAUSED AT L1 BLOCK\tWINDOW\tQUEUED")
+	for _, status := range statuses {
+		if !status.Paused {
+			fmt.Fprintf(w, "%d\trunning\t-\t-\t-\t-\n", status.ChainID)
+			continue
+		}
+		fmt.Fprintf(w, "%d\tpaused\t%s\t%d\t%d\t%d\n", status.ChainID, status.Mode, status.PausedAtL1Block, status.SequencingWindow, len(status.QueuedTxs))
+	}
+	return w.Flush()
+}
+
+func sequencerChainID(ctx *cli.Context, client *admin.Client) (uint64, error) {
+	doc, err := client.GetConfig(ctx.Context)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get config: %w", err)
+	}
+	return resolveChainID(doc, ctx.String(config.ChainFlagName))
+}
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/devkeys"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// DefaultDevAccountBalance is the balance, in ether, of the accounts derived from a secrets config
+	DefaultDevAccountBalance = "10000"
+)
+
+// DevAccount is a prefunded account derived from a secrets config
+type DevAccount struct {
+	Address    common.Address `json:"address"`
+	PrivateKey string         `json:"privateKey"`
+}
+
+// FundedAccount is an address funded on startup with ETH, or an ERC20 token when specified
+type FundedAccount struct {
+	Address common.Address  `json:"address"`
+	Amount  string          `json:"amount"`
+	Token   *common.Address `json:"token,omitempty"`
+}
+
+// accountKey is the key of an account derived from the base derivation path of a secrets config
+type accountKey struct {
+	path  accounts.DerivationPath
+	index uint64
+}
+
+func (k accountKey) HDPath() string {
+	return fmt.Sprintf("%s/%d", k.path, k.index)
+}
+
+func (k accountKey) String() string {
+	return fmt.Sprintf("account-%d", k.index)
+}
+
+// IsDefault is true for the test mnemonic & accounts included in the genesis of the local chains
+func (s SecretsConfig) IsDefault() bool {
+	return s.Mnemonic == DefaultSecretsConfig.Mnemonic &&
+		s.Accounts == DefaultSecretsConfig.Accounts &&
+		s.DerivationPath.String() == DefaultSecretsConfig.DerivationPath.String()
+}
+
+// DevAccounts derives the prefunded accounts of the secrets config. The mnemonic is expected to be valid, see `Check`
+func (s SecretsConfig) DevAccounts() []DevAccount {
+	keys, err := devkeys.NewMnemonicDevKeys(s.Mnemonic)
+	if err != nil {
+		panic(err)
+	}
+
+	devAccounts := make([]DevAccount, 0, s.Accounts)
+	for i := range s.Accounts {
+		privateKey, err := keys.Secret(accountKey{s.DerivationPath, i})
+		if err != nil {
+			panic(err)
+		}
+		devAccounts = append(devAccounts, DevAccount{
+			Address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+			PrivateKey: hexutil.Encode(crypto.FromECDSA(privateKey)),
+		})
+	}
+	return devAccounts
+}
+
+// Check ensures the mnemonic & derivation path produce accounts
+func (s SecretsConfig) Check() error {
+	if s.Accounts == 0 {
+		return fmt.Errorf("the number of accounts must be greater than zero")
+	}
+	if len(s.DerivationPath) == 0 {
+		return fmt.Errorf("a derivation path is required")
+	}
+	if _, err := devkeys.NewMnemonicDevKeys(s.Mnemonic); err != nil {
+		return err
+	}
+	return nil
+}
+
+func SecretsConfigAsString(s SecretsConfig) string {
+	devAccounts := s.DevAccounts()
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "\nAvailable Accounts\n")
+	fmt.Fprintf(&b, "-----------------------\n")
+
+	for i, account := range devAccounts {
+		fmt.Fprintf(&b, "(%d): %s\n", i, account.Address.Hex())
+	}
+
+	fmt.Fprintf(&b, "\nPrivate Keys\n")
+	fmt.Fprintf(&b, "-----------------------\n")
+
+	for i, account := range devAccounts {
+		fmt.Fprintf(&b, "(%d): %s\n", i, account.PrivateKey)
+	}
+
+	return b.String()
+}
+
+// ParseFundedAccount parses `[<chain>:]<address>=<amount>[@<token>]`, where the amount is in ether or whole tokens.
+// The chain, by name or id, is empty when the account is funded on every chain.
+func ParseFundedAccount(value string) (string, FundedAccount, error) {
+	var chain string
+	if before, after, found := strings.Cut(value, ":"); found {
+		chain, value = before, after
+	}
+
+	address, amount, found := strings.Cut(value, "=")
+	if !found || !common.IsHexAddress(address) {
+		return "", FundedAccount{}, fmt.Errorf("expected [<chain>:]<address>=<amount>[@<token>], got `%s`", value)
+	}
+
+	account := FundedAccount{Address: common.HexToAddress(address)}
+	amount, token, found := strings.Cut(amount, "@")
+	if found {
+		if !common.IsHexAddress(token) {
+			return "", FundedAccount{}, fmt.Errorf("invalid token address `%s`", token)
+		}
+		tokenAddr := common.HexToAddress(token)
+		account.Token = &tokenAddr
+	}
+	account.Amount = amount
+
+	if amount, ok := new(big.Rat).SetString(account.Amount); !ok || amount.Sign() < 0 {
+		return "", FundedAccount{}, fmt.Errorf("invalid amount `%s`", account.Amount)
+	}
+	return chain, account, nil
+}
+
+// BaseUnits converts the amount into the smallest unit of ETH, or the token with the specified decimals
+func (a FundedAccount) BaseUnits(decimals uint8) (*big.Int, error) {
+	return ParseAmount(a.Amount, decimals)
+}
+
+// FormatAmount converts an amount in the smallest unit with the specified decimals into a decimal amount,
+// the inverse of `ParseAmount`
+func FormatAmount(amount *big.Int, decimals uint8) string {
+	value := new(big.Rat).SetFrac(amount, new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil))
+	formatted := value.FloatString(int(decimals))
+	if strings.Contains(formatted, ".") {
+		formatted = strings.TrimRight(strings.TrimRight(formatted, "0"), ".")
+	}
+	return formatted
+}
+
+// ParseAmount converts a decimal amount of ether, or whole tokens, into the smallest unit with the specified decimals
+func ParseAmount(value string, decimals uint8) (*big.Int, error) {
+	amount, ok := new(big.Rat).SetString(value)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount `%s`", value)
+	}
+
+	amount.Mul(amount, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	if !amount.IsInt() {
+		return nil, fmt.Errorf("amount `%s` has more than %d decimals", value, decimals)
+	}
+	return amount.Num(), nil
+}
@@ -0,0 +1,131 @@
+package config
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecretsConfigDevAccounts(t *testing.T) {
+	devAccounts := DefaultSecretsConfig.DevAccounts()
+	require.Len(t, devAccounts, int(DefaultSecretsConfig.Accounts))
+	require.Equal(t, common.HexToAddress("0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266"), devAccounts[0].Address)
+	require.Equal(t, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", devAccounts[0].PrivateKey)
+	require.Equal(t, common.HexToAddress("0xa0Ee7A142d267C1f36714E4a8F75612F20a79720"), devAccounts[9].Address)
+	require.True(t, DefaultSecretsConfig.IsDefault())
+
+	// accounts of another derivation path
+	derivationPath, err := accounts.ParseDerivationPath("m/44'/60'/1'/0")
+	require.NoError(t, err)
+
+	secretsConfig := SecretsConfig{Accounts: 2, Mnemonic: DefaultSecretsConfig.Mnemonic, DerivationPath: derivationPath}
+	require.NoError(t, secretsConfig.Check())
+	require.False(t, secretsConfig.IsDefault())
+
+	customAccounts := secretsConfig.DevAccounts()
+	require.Len(t, customAccounts, 2)
+	require.NotEqual(t, devAccounts[0].Address, customAccounts[0].Address)
+	require.NotEqual(t, customAccounts[0].Address, customAccounts[1].Address)
+
+	require.Error(t, SecretsConfig{Accounts: 1, Mnemonic: "not a mnemonic", DerivationPath: derivationPath}.Check())
+	require.Error(t, SecretsConfig{Accounts: 0, Mnemonic: DefaultSecretsConfig.Mnemonic, DerivationPath: derivationPath}.Check())
+}
+
+func TestParseFundedAccount(t *testing.T) {
+	chain, account, err := ParseFundedAccount("0x0000000000000000000000000000000000000001=1.5")
+	require.NoError(t, err)
+	require.Equal(t, "", chain)
+	require.Equal(t, common.HexToAddress("0x1"), account.Address)
+	require.Nil(t, account.Token)
+
+	balance, err := account.BaseUnits(18)
+	require.NoError(t, err)
+	require.Equal(t, "1500000000000000000", balance.String())
+
+	chain, account, err = ParseFundedAccount("OPChainA:0x0000000000000000000000000000000000000001=100@0x0000000000000000000000000000000000000002")
+	require.NoError(t, err)
+	require.Equal(t, "OPChainA", chain)
+	require.Equal(t, common.HexToAddress("0x2"), *account.Token)
+
+	balance, err = account.BaseUnits(6)
+	require.NoError(t, err)
+	require.Equal(t, "100000000", balance.String())
+
+	// too many decimals for the token
+	_, account, err = ParseFundedAccount("0x0000000000000000000000000000000000000001=0.0000001")
+	require.NoError(t, err)
+	_, err = account.BaseUnits(6)
+	require.Error(t, err)
+
+	for _, invalid := range []string{"0x01", "0x0000000000000000000000000000000000000001=abc", "0x0000000000000000000000000000000000000001=-1", "0x0000000000000000000000000000000000000001=1@token"} {
+		_, _, err := ParseFundedAccount(invalid)
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestFormatAmount(t *testing.T) {
+	require.Equal(t, "0.5", FormatAmount(big.NewInt(500000000000000000), 18))
+	require.Equal(t, "1.25", FormatAmount(big.NewInt(1250000), 6))
+	require.Equal(t, "2", FormatAmount(big.NewInt(2000000000000000000), 18))
+	require.Equal(t, "0", FormatAmount(big.NewInt(0), 18))
+	require.Equal(t, "42", FormatAmount(big.NewInt(42), 0))
+
+	amount, err := ParseAmount("3.000001", 18)
+	require.NoError(t, err)
+	require.Equal(t, "3.000001", FormatAmount(amount, 18))
+}
+
+func TestParseAmount(t *testing.T) {
+	amount, err := ParseAmount("0.5", 18)
+	require.NoError(t, err)
+	require.Equal(t, "500000000000000000", amount.String())
+
+	amount, err = ParseAmount("42", 0)
+	require.NoError(t, err)
+	require.Equal(t, "42", amount.String())
+
+	_, err = ParseAmount("0.5", 0)
+	require.Error(t, err)
+	_, err = ParseAmount("-1", 18)
+	require.Error(t, err)
+	_, err = ParseAmount("one", 18)
+	require.Error(t, err)
+}
+
+func TestApplyAccountsConfig(t *testing.T) {
+	networkConfig := GetDefaultNetworkConfig(0, "")
+	fundedAccount := FundedAccount{Address: common.HexToAddress("0x1"), Amount: "1"}
+	chainFundedAccount := FundedAccount{Address: common.HexToAddress("0x2"), Amount: "2"}
+
+	cliConfig := &CLIConfig{
+		FundedAccounts:      []FundedAccount{fundedAccount},
+		ChainFundedAccounts: map[string][]FundedAccount{"902": {chainFundedAccount}},
+	}
+	require.NoError(t, cliConfig.ApplyAccountsConfig(&networkConfig))
+
+	// the default accounts are included in genesis
+	require.True(t, networkConfig.L1Config.SecretsConfig.IsDefault())
+	require.Equal(t, []FundedAccount{fundedAccount}, networkConfig.L1Config.FundedAccounts)
+	require.Equal(t, []FundedAccount{fundedAccount}, networkConfig.L2Configs[0].FundedAccounts)
+	require.Equal(t, []FundedAccount{fundedAccount, chainFundedAccount}, networkConfig.L2Configs[1].FundedAccounts)
+
+	// custom accounts are funded on every chain
+	networkConfig = GetDefaultNetworkConfig(0, "")
+	secretsConfig := DefaultSecretsConfig
+	secretsConfig.Accounts = 2
+	require.NoError(t, (&CLIConfig{SecretsConfig: &secretsConfig}).ApplyAccountsConfig(&networkConfig))
+
+	require.Equal(t, uint64(2), networkConfig.L2Configs[0].SecretsConfig.Accounts)
+	require.Len(t, networkConfig.L2Configs[0].FundedAccounts, 2)
+	require.Equal(t, DefaultDevAccountBalance, networkConfig.L2Configs[0].FundedAccounts[0].Amount)
+}
+
+func TestApplyAccountsConfigUnknownChain(t *testing.T) {
+	networkConfig := GetDefaultNetworkConfig(0, "")
+	account := FundedAccount{Address: common.HexToAddress("0x1"), Amount: "1"}
+	require.Error(t, (&CLIConfig{ChainFundedAccounts: map[string][]FundedAccount{"999": {account}}}).ApplyAccountsConfig(&networkConfig))
+	require.NoError(t, (&CLIConfig{ChainFundedAccounts: map[string][]FundedAccount{"OPChainA": {account}}}).ApplyAccountsConfig(&networkConfig))
+}
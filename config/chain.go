@@ -4,20 +4,28 @@ import (
 	"context"
 	"fmt"
 	"math/big"
-	"strings"
 
-	"github.com/ethereum-optimism/optimism/op-chain-ops/devkeys"
 	registry "github.com/ethereum-optimism/superchain-registry/superchain"
 	"github.com/ethereum-optimism/supersim/genesis"
 
 	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 )
 
+const (
+	// DefaultBlockTime is used by chains that do not specify a block time
+	DefaultBlockTime = 2
+
+	// DefaultProposerInterval is the time in seconds between output proposals of the local L2s
+	DefaultProposerInterval = 12
+
+	// DefaultSequencingWindow is the `seq_window_size` of the superchain, in L1 blocks, after which an L2 only
+	// includes deposits while its sequencer is down
+	DefaultSequencingWindow = 3600
+)
+
 var (
 	DefaultSecretsConfig = SecretsConfig{
 		Accounts:       10,
@@ -26,9 +34,39 @@ var (
 	}
 )
 
+type MiningMode string
+
+const (
+	// IntervalMiningMode mines a new block every `BlockTime` seconds
+	IntervalMiningMode MiningMode = "interval"
+	// AutomineMiningMode mines a new block for every transaction
+	AutomineMiningMode MiningMode = "automine"
+	// ManualMiningMode only mines blocks when explicitly requested
+	ManualMiningMode MiningMode = "manual"
+)
+
+var MiningModes = []MiningMode{IntervalMiningMode, AutomineMiningMode, ManualMiningMode}
+
+func (m MiningMode) IsValid() bool {
+	for _, mode := range MiningModes {
+		if m == mode {
+			return true
+		}
+	}
+	return false
+}
+
 type ForkConfig struct {
 	RPCUrl      string
 	BlockNumber uint64
+
+	// Genesis time & block time of a forked L2, used to derive its height from an L1 block
+	GenesisL2Time uint64
+	L2BlockTime   uint64
+
+	// Disables the local cache of forked state such that every
+	// read is served by the rpc, i.e when recording or replaying
+	NoStorageCaching bool
 }
 
 type SecretsConfig struct {
@@ -60,8 +98,19 @@ type ChainConfig struct {
 	// Optional
 	StartingTimestamp uint64
 
+	// Mining configuration. An empty mining mode defaults
+	// to interval mining with `DefaultBlockTime`
+	BlockTime  uint64
+	MiningMode MiningMode
+
 	// Optional
 	LogsDirectory string
+
+	// Optional. Hardfork of the anvil instance, the latest when empty
+	Hardfork string
+
+	// Optional. Accounts funded on startup, in addition to the accounts of the secrets config
+	FundedAccounts []FundedAccount
 }
 
 type NetworkConfig struct {
@@ -74,6 +123,19 @@ type NetworkConfig struct {
 	// check if Interop is enabled
 	InteropEnabled   bool
 	InteropAutoRelay bool
+
+	// Deployed at the same address on every L2 once interop is configured
+	SuperchainERC20s []Token
+
+	// Deployed to the L1, with an OptimismMintableERC20 on every L2
+	L1Tokens []Token
+
+	// Seconds between dispute games posted for the outputs of
+	// the local L2s. The proposer is disabled when zero
+	ProposerInterval uint64
+
+	// Deployments of the genesis the local chains run from, nil in fork mode
+	Manifest *genesis.Manifest
 }
 
 type Chain interface {
@@ -89,81 +151,129 @@ type Chain interface {
 	SetStorageAt(ctx context.Context, result interface{}, address common.Address, storageSlot string, storageValue string) error
 	SetBalance(ctx context.Context, result interface{}, address common.Address, value *big.Int) error
 	SetIntervalMining(ctx context.Context, result interface{}, interval int64) error
+	SetAutomine(ctx context.Context, result interface{}, enabled bool) error
+	SetNonce(ctx context.Context, result interface{}, address common.Address, nonce uint64) error
+
+	// Time & mining controls
+	Snapshot(ctx context.Context, result interface{}) error
+	Revert(ctx context.Context, result interface{}, snapshotID string) error
+	Mine(ctx context.Context, result interface{}, blocks uint64) error
+	SetNextBlockTimestamp(ctx context.Context, result interface{}, timestamp uint64) error
+	IncreaseTime(ctx context.Context, result interface{}, seconds uint64) error
+	SetBlockTimestampInterval(ctx context.Context, result interface{}, seconds uint64) error
+	RemoveBlockTimestampInterval(ctx context.Context, result interface{}) error
+
+	// Re-forks the chain from the rpc at the specified block number
+	Reset(ctx context.Context, result interface{}, forkURL string, blockNumber uint64) error
+
+	// Impersonation
+	ImpersonateAccount(ctx context.Context, result interface{}, address common.Address) error
+	StopImpersonatingAccount(ctx context.Context, result interface{}, address common.Address) error
 
 	// Lifecycle
 	Start(ctx context.Context) error
 	Stop(ctx context.Context) error
 }
 
+// defaultL2Count is the number of L2s of the embedded genesis run by default
+const defaultL2Count = 2
+
 func GetDefaultNetworkConfig(startingTimestamp uint64, logsDirectory string) NetworkConfig {
-	return NetworkConfig{
+	deployment := genesis.GeneratedGenesisDeployment
+	return NetworkConfigFromGenesisDeployment(&genesis.GenesisDeployment{L1: deployment.L1, L2s: deployment.L2s[:defaultL2Count], Superchain: deployment.Superchain}, startingTimestamp, logsDirectory)
+}
+
+// defaultL2ChainIDs returns the chain ids of the L2s run by default
+func defaultL2ChainIDs() []uint64 {
+	chainIDs := make([]uint64, defaultL2Count)
+	for i, l2 := range genesis.GeneratedGenesisDeployment.L2s[:defaultL2Count] {
+		chainIDs[i] = l2.ChainID
+	}
+	return chainIDs
+}
+
+// NetworkConfigFromGenesisDeployment returns the configuration of a local network
+// running the L1 and every L2 of the genesis deployment.
+func NetworkConfigFromGenesisDeployment(deployment *genesis.GenesisDeployment, startingTimestamp uint64, logsDirectory string) NetworkConfig {
+	networkConfig := NetworkConfig{
 		// Enabled by default as it is included in genesis
 		InteropEnabled: true,
+		Manifest:       deployment.Manifest(),
 
 		L1Config: ChainConfig{
 			Name:              "Local",
-			ChainID:           genesis.GeneratedGenesisDeployment.L1.ChainID,
+			ChainID:           deployment.L1.ChainID,
 			SecretsConfig:     DefaultSecretsConfig,
-			GenesisJSON:       genesis.GeneratedGenesisDeployment.L1.GenesisJSON,
+			GenesisJSON:       deployment.L1.GenesisJSON,
 			StartingTimestamp: startingTimestamp,
 			LogsDirectory:     logsDirectory,
-		},
-		L2Configs: []ChainConfig{
-			{
-				Name:          "OPChainA",
-				ChainID:       genesis.GeneratedGenesisDeployment.L2s[0].ChainID,
-				SecretsConfig: DefaultSecretsConfig,
-				GenesisJSON:   genesis.GeneratedGenesisDeployment.L2s[0].GenesisJSON,
-				L2Config: &L2Config{
-					L1ChainID:     genesis.GeneratedGenesisDeployment.L1.ChainID,
-					L1Addresses:   genesis.GeneratedGenesisDeployment.L2s[0].RegistryAddressList(),
-					DependencySet: []uint64{genesis.GeneratedGenesisDeployment.L2s[1].ChainID},
-				},
-				StartingTimestamp: startingTimestamp,
-				LogsDirectory:     logsDirectory,
-			},
-			{
-				Name:          "OPChainB",
-				ChainID:       genesis.GeneratedGenesisDeployment.L2s[1].ChainID,
-				SecretsConfig: DefaultSecretsConfig,
-				GenesisJSON:   genesis.GeneratedGenesisDeployment.L2s[1].GenesisJSON,
-				L2Config: &L2Config{
-					L1ChainID:     genesis.GeneratedGenesisDeployment.L1.ChainID,
-					L1Addresses:   genesis.GeneratedGenesisDeployment.L2s[1].RegistryAddressList(),
-					DependencySet: []uint64{genesis.GeneratedGenesisDeployment.L2s[0].ChainID},
-				},
-				StartingTimestamp: startingTimestamp,
-				LogsDirectory:     logsDirectory,
-			},
+			BlockTime:         DefaultBlockTime,
+			MiningMode:        IntervalMiningMode,
 		},
 	}
-}
 
-// Note: The default secrets config is used everywhere
-func DefaultSecretsConfigAsString() string {
+	for i, l2Deployment := range deployment.L2s {
+		l2ChainConfig := LocalL2ChainConfig(l2Deployment, i, deployment.L1.ChainID, startingTimestamp, logsDirectory)
+		networkConfig.L2Configs = append(networkConfig.L2Configs, l2ChainConfig)
 
-	keys, err := devkeys.NewMnemonicDevKeys(devkeys.TestMnemonic)
-	if err != nil {
-		panic(err)
+		// The interop predeploys are only included when interop activates, at genesis or later
+		if !l2Deployment.Interop {
+			networkConfig.InteropEnabled = false
+		}
 	}
 
-	var b strings.Builder
-
-	fmt.Fprintf(&b, "\nAvailable Accounts\n")
-	fmt.Fprintf(&b, "-----------------------\n")
+	networkConfig.SetDependencySets()
+	return networkConfig
+}
 
-	for i := range DefaultSecretsConfig.Accounts {
-		address, _ := keys.Address(devkeys.UserKey(i))
-		fmt.Fprintf(&b, "(%d): %s\n", i, address.Hex())
+// LocalL2ChainConfig returns the configuration of the L2 genesis deployment, named by its index.
+// The L1 deployment of this chain is found in the L1 genesis of the same deployment.
+func LocalL2ChainConfig(deployment *genesis.L2GenesisDeployment, index int, l1ChainID uint64, startingTimestamp uint64, logsDirectory string) ChainConfig {
+	name := fmt.Sprintf("OPChain%c", 'A'+index)
+	if index >= 26 {
+		name = fmt.Sprintf("OPChain%d", deployment.ChainID)
 	}
 
-	fmt.Fprintf(&b, "\nPrivate Keys\n")
-	fmt.Fprintf(&b, "-----------------------\n")
+	return ChainConfig{
+		Name:          name,
+		ChainID:       deployment.ChainID,
+		SecretsConfig: DefaultSecretsConfig,
+		GenesisJSON:   deployment.GenesisJSON,
+		L2Config: &L2Config{
+			L1ChainID:   l1ChainID,
+			L1Addresses: deployment.RegistryAddressList(),
+		},
+		StartingTimestamp: startingTimestamp,
+		LogsDirectory:     logsDirectory,
+		BlockTime:         DefaultBlockTime,
+		MiningMode:        IntervalMiningMode,
+		Hardfork:          anvilHardfork(deployment.Hardfork),
+	}
+}
 
-	for i := range DefaultSecretsConfig.Accounts {
-		privateKey, _ := keys.Secret(devkeys.UserKey(i))
-		fmt.Fprintf(&b, "(%d): %s\n", i, hexutil.Encode(crypto.FromECDSA(privateKey)))
+// anvilHardfork returns the anvil hardfork matching the OP Stack hardfork active at genesis. Delta has no
+// execution changes and runs as Canyon, while interop, which anvil does not know of, runs on the latest hardfork.
+// Anvil keeps this hardfork while running, such that later scheduled activations do not change execution.
+func anvilHardfork(hardfork string) string {
+	switch hardfork {
+	case "", "interop":
+		return ""
+	case "delta":
+		return "canyon"
+	default:
+		return hardfork
 	}
+}
 
-	return b.String()
+// SetDependencySets configures every L2 in the network to depend on all other L2s
+func (n *NetworkConfig) SetDependencySets() {
+	for i := range n.L2Configs {
+		var dependencySet []uint64
+		for j := range n.L2Configs {
+			if n.L2Configs[j].ChainID != n.L2Configs[i].ChainID {
+				dependencySet = append(dependencySet, n.L2Configs[j].ChainID)
+			}
+		}
+		n.L2Configs[i].L2Config.DependencySet = dependencySet
+	}
 }
@@ -2,11 +2,20 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/ethereum-optimism/optimism/op-chain-ops/interopgen"
 	opservice "github.com/ethereum-optimism/optimism/op-service"
 
 	registry "github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/ethereum-optimism/supersim/genesis"
+	"github.com/ethereum-optimism/supersim/genesis/worldgen"
+
+	"github.com/ethereum/go-ethereum/accounts"
 
 	"github.com/urfave/cli/v2"
 )
@@ -15,18 +24,86 @@ const (
 	ForkCommandName = "fork"
 
 	AdminPortFlagName = "admin.port"
+	AdminRPCFlagName  = "admin.rpc"
+
+	L1ForkHeightFlagName    = "l1.fork.height"
+	L1ForkURLFlagName       = "l1.fork.url"
+	ForkTimestampFlagName   = "fork.timestamp"
+	ForkChainHeightFlagName = "fork.chain.height"
+	L1PortFlagName          = "l1.port"
+	L1BlockTimeFlagName     = "l1.block.time"
+	L1MiningModeFlagName    = "l1.mining.mode"
+
+	L2BlockTimeFlagName  = "l2.block.time"
+	L2MiningModeFlagName = "l2.mining.mode"
 
-	L1ForkHeightFlagName = "l1.fork.height"
-	L1PortFlagName       = "l1.port"
+	ChainBlockTimeFlagName  = "chain.block.time"
+	ChainMiningModeFlagName = "chain.mining.mode"
 
 	ChainsFlagName         = "chains"
+	CustomChainsFlagName   = "custom.chains"
 	NetworkFlagName        = "network"
 	L2StartingPortFlagName = "l2.starting.port"
 
 	LogsDirectoryFlagName = "logs.directory"
+	ConfigOutFlagName     = "config.out"
+
+	LocalChainsFlagName = "local.chains"
+
+	ForkRecordFlagName = "fork.record"
+	ForkReplayFlagName = "fork.replay"
+
+	ReplayTxsFlagName = "replay.txs"
+
+	ExportFormatFlagName = "format"
+	ExportOutFlagName    = "out"
+
+	PrivateKeyFlagName    = "private.key"
+	ChainFlagName         = "chain"
+	AmountFlagName        = "amount"
+	TokenFlagName         = "token"
+	RecipientFlagName     = "to"
+	MessageStatusFlagName = "status"
+	SenderFlagName        = "from"
+	SourceFlagName        = "source"
+	DestinationFlagName   = "destination"
+
+	SequencerModeFlagName    = "mode"
+	SequencingWindowFlagName = "sequencing.window"
+
+	BytecodeFlagName        = "bytecode"
+	ArtifactFlagName        = "artifact"
+	ConstructorArgsFlagName = "constructor.args"
+	SaltFlagName            = "salt"
+	DeployL1FlagName        = "l1"
+
+	GenesisDirFlagName                 = "genesis.dir"
+	GenesisL1ChainIDFlagName           = "genesis.l1.chain.id"
+	GenesisL2ChainIDsFlagName          = "genesis.l2.chain.ids"
+	GenesisTimestampFlagName           = "genesis.timestamp"
+	GenesisMonorepoArtifactsFlagName   = "genesis.monorepo.artifacts"
+	GenesisPeripheryArtifactsFlagName  = "genesis.periphery.artifacts"
+	GenesisCacheDirFlagName            = "genesis.cache.dir"
+	GenesisHardforkFlagName            = "genesis.hardfork"
+	GenesisHardforkActivationsFlagName = "genesis.hardfork.activations"
+	GenesisUserArtifactsFlagName       = "genesis.user.artifacts"
+	GenesisUserScriptFlagName          = "genesis.user.script"
+	GenesisUserAllocsFlagName          = "genesis.user.allocs"
+
+	InteropEnabledFlagName      = "interop.enabled"
+	InteropAutoRelayFlagName    = "interop.autorelay"
+	InteropTokenFlagName        = "interop.token"
+	InteropTokenBalanceFlagName = "interop.token.balance"
 
-	InteropEnabledFlagName   = "interop.enabled"
-	InteropAutoRelayFlagName = "interop.autorelay"
+	L1TokenFlagName        = "l1.token"
+	L1TokenBalanceFlagName = "l1.token.balance"
+
+	ProposerIntervalFlagName = "proposer.interval"
+
+	AccountsMnemonicFlagName       = "accounts.mnemonic"
+	AccountsDerivationPathFlagName = "accounts.derivation.path"
+	AccountsCountFlagName          = "accounts.count"
+	FundFlagName                   = "fund"
 )
 
 func BaseCLIFlags(envPrefix string) []cli.Flag {
@@ -55,12 +132,412 @@ func BaseCLIFlags(envPrefix string) []cli.Flag {
 			Usage:   "Automatically relay messages sent to the L2ToL2CrossDomainMessenger using account 0xa0Ee7A142d267C1f36714E4a8F75612F20a79720",
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "INTEROP_AUTORELAY"),
 		},
+		&cli.StringSliceFlag{
+			Name:    InteropTokenFlagName,
+			Usage:   "SuperchainERC20 tokens deployed at the same address on every L2 on startup, specified as <name>:<symbol>[:<decimals>]. i.e --interop.token \"My Token\":MTK:6",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "INTEROP_TOKEN"),
+		},
+		&cli.StringSliceFlag{
+			Name:    InteropTokenBalanceFlagName,
+			Usage:   "Balances of the --interop.token tokens minted on every L2, specified as <symbol>:<address>=<amount> in whole tokens. i.e --interop.token.balance MTK:0xabc...=1000",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "INTEROP_TOKEN_BALANCE"),
+		},
+		&cli.StringSliceFlag{
+			Name:    L1TokenFlagName,
+			Usage:   "ERC20 tokens deployed to the L1 on startup, with an OptimismMintableERC20 on every L2 to deposit to through the L1StandardBridge, specified as <name>:<symbol>[:<decimals>]. i.e --l1.token \"Test Token\":TEST",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_TOKEN"),
+		},
+		&cli.StringSliceFlag{
+			Name:    L1TokenBalanceFlagName,
+			Usage:   "Balances of the --l1.token tokens minted on the L1, specified as <symbol>:<address>=<amount> in whole tokens. i.e --l1.token.balance TEST:0xabc...=1000",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_TOKEN_BALANCE"),
+		},
+		&cli.Uint64Flag{
+			Name:    ProposerIntervalFlagName,
+			Usage:   "Interval in seconds at which a dispute game is posted to the L1 for the latest output of each local L2, such that withdrawals can be proven. `0` disables the proposer",
+			Value:   DefaultProposerInterval,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "PROPOSER_INTERVAL"),
+		},
 		&cli.StringFlag{
 			Name:    LogsDirectoryFlagName,
 			Usage:   "Directory to store logs",
 			Value:   "",
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "LOGS_DIRECTORY"),
 		},
+		&cli.StringFlag{
+			Name:    ConfigOutFlagName,
+			Usage:   "File to write the configuration of the started chains to as JSON, the same document served on the admin server at /config",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "CONFIG_OUT"),
+		},
+		&cli.Uint64Flag{
+			Name:    L1BlockTimeFlagName,
+			Usage:   "Block time in seconds of the L1 instance when interval mining",
+			Value:   DefaultBlockTime,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_BLOCK_TIME"),
+		},
+		&cli.StringFlag{
+			Name:    L1MiningModeFlagName,
+			Usage:   fmt.Sprintf("Mining mode of the L1 instance. options: %s", miningModesString()),
+			Value:   string(IntervalMiningMode),
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_MINING_MODE"),
+		},
+		&cli.Uint64Flag{
+			Name:    L2BlockTimeFlagName,
+			Usage:   "Block time in seconds of the L2 instances when interval mining. `0` uses the block time of the chain",
+			Value:   0,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L2_BLOCK_TIME"),
+		},
+		&cli.StringFlag{
+			Name:    L2MiningModeFlagName,
+			Usage:   fmt.Sprintf("Mining mode of the L2 instances. options: %s", miningModesString()),
+			Value:   string(IntervalMiningMode),
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L2_MINING_MODE"),
+		},
+		&cli.StringSliceFlag{
+			Name:    ChainBlockTimeFlagName,
+			Usage:   "Per-chain block time overrides specified as <chain>=<seconds>, where <chain> is the chain name or id. i.e --chain.block.time 900=12,901=1",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "CHAIN_BLOCK_TIME"),
+		},
+		&cli.StringFlag{
+			Name:    AccountsMnemonicFlagName,
+			Usage:   "Mnemonic the prefunded dev accounts of every chain are derived from",
+			Value:   DefaultSecretsConfig.Mnemonic,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "ACCOUNTS_MNEMONIC"),
+		},
+		&cli.StringFlag{
+			Name:    AccountsDerivationPathFlagName,
+			Usage:   "Base derivation path of the prefunded dev accounts, the account index is appended",
+			Value:   DefaultSecretsConfig.DerivationPath.String(),
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "ACCOUNTS_DERIVATION_PATH"),
+		},
+		&cli.Uint64Flag{
+			Name:    AccountsCountFlagName,
+			Usage:   "Number of prefunded dev accounts",
+			Value:   DefaultSecretsConfig.Accounts,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "ACCOUNTS_COUNT"),
+		},
+		&cli.StringSliceFlag{
+			Name:    FundFlagName,
+			Usage:   "Addresses funded on startup specified as [<chain>:]<address>=<amount>[@<token>], where <chain> is the chain name or id, every chain when omitted, and <amount> is in ether or whole tokens of the ERC20 <token>. i.e --fund 0xabc...=100,901:0xabc...=1000@0xdef...",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "FUND"),
+		},
+		&cli.StringSliceFlag{
+			Name:    ChainMiningModeFlagName,
+			Usage:   "Per-chain mining mode overrides specified as <chain>=<mode>, where <chain> is the chain name or id. i.e --chain.mining.mode 901=automine",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "CHAIN_MINING_MODE"),
+		},
+	}
+}
+
+// GenesisCLIFlags configure the genesis of the local chains in vanilla mode. By default,
+// the genesis embedded in supersim is used.
+func GenesisCLIFlags(envPrefix string) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    GenesisDirFlagName,
+			Usage:   "Directory of a pre-generated genesis bundle, i.e output of the genesis generator, to run the local chains from",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_DIR"),
+		},
+		&cli.Uint64Flag{
+			Name:    GenesisL1ChainIDFlagName,
+			Usage:   "Chain ID of the L1 when generating the genesis at startup",
+			Value:   genesis.GeneratedGenesisDeployment.L1.ChainID,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_L1_CHAIN_ID"),
+		},
+		&cli.Uint64SliceFlag{
+			Name:    GenesisL2ChainIDsFlagName,
+			Usage:   "Chain IDs of the L2s to generate the genesis for at startup. i.e --genesis.l2.chain.ids 10,8453. With a user genesis, defaults to the chains of per-chain user allocs, otherwise the L2s run by default",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_L2_CHAIN_IDS"),
+		},
+		&cli.Uint64Flag{
+			Name:    GenesisTimestampFlagName,
+			Usage:   "Genesis timestamp of the generated chains. `0` for the current time",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_TIMESTAMP"),
+		},
+		&cli.StringFlag{
+			Name:    GenesisMonorepoArtifactsFlagName,
+			Usage:   "URL to the monorepo contract artifacts used to generate the genesis, can be https:// or a fs path",
+			Value:   worldgen.DefaultMonorepoArtifacts,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_MONOREPO_ARTIFACTS"),
+		},
+		&cli.StringFlag{
+			Name:    GenesisPeripheryArtifactsFlagName,
+			Usage:   "URL to the periphery contract artifacts used to generate the genesis, can be https:// or a fs path",
+			Value:   worldgen.DefaultPeripheryArtifacts,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_PERIPHERY_ARTIFACTS"),
+		},
+		&cli.StringFlag{
+			Name:    GenesisCacheDirFlagName,
+			Usage:   "Directory to cache generated genesis bundles in, reused across runs with the same recipe",
+			Value:   defaultGenesisCacheDir(),
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_CACHE_DIR"),
+		},
+		&cli.StringFlag{
+			Name:    GenesisHardforkFlagName,
+			Usage:   fmt.Sprintf("OP Stack hardfork of the generated L2s at genesis, along with every prior hardfork. Supported: [%s]. Isthmus is not supported by the pinned op-chain-ops", strings.Join(worldgen.Hardforks, ", ")),
+			Value:   worldgen.DefaultHardfork,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_HARDFORK"),
+		},
+		&cli.StringSliceFlag{
+			Name:    GenesisHardforkActivationsFlagName,
+			Usage:   "Activation of hardforks after the genesis hardfork of the generated L2s, in seconds after genesis. i.e holocene=3600,interop=7200. Anvil keeps executing with the genesis hardfork",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_HARDFORK_ACTIVATIONS"),
+		},
+		&cli.StringFlag{
+			Name:    GenesisUserArtifactsFlagName,
+			Usage:   "URL to the foundry artifacts of the user contracts deployed in the generated genesis, can be https:// or a fs path",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_USER_ARTIFACTS"),
+		},
+		&cli.StringFlag{
+			Name:    GenesisUserScriptFlagName,
+			Usage:   "Script, `<file>:<contract>`, in the user artifacts whose run() deploys the user contracts into every generated L2 genesis",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_USER_SCRIPT"),
+		},
+		&cli.StringFlag{
+			Name:    GenesisUserAllocsFlagName,
+			Usage:   "Path to a JSON of accounts, in the format of a genesis alloc, included in every generated L2 genesis. Or a JSON of L2 chain id to genesis alloc",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "GENESIS_USER_ALLOCS"),
+		},
+	}
+}
+
+func defaultGenesisCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "supersim", "genesis")
+}
+
+// AdminClientCLIFlags are used by subcommands that operate on an already running instance
+func AdminClientCLIFlags(envPrefix string) []cli.Flag {
+	return []cli.Flag{
+		&cli.StringFlag{
+			Name:    AdminRPCFlagName,
+			Usage:   "Admin server endpoint of the running supersim instance",
+			Value:   "http://127.0.0.1:8420",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "ADMIN_RPC"),
+		},
+	}
+}
+
+// ReforkCLIFlags are used to re-fork an already running instance to a new height
+func ReforkCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		&cli.Uint64Flag{
+			Name:  L1ForkHeightFlagName,
+			Usage: "L1 height to re-fork the superchain to (bounds L2 time). `0` for latest",
+			Value: 0,
+		},
+		&cli.StringSliceFlag{
+			Name:  ForkChainHeightFlagName,
+			Usage: "L2 heights to re-fork chains to, instead of deriving them from the L1 fork height, specified as <chain>=<height>. i.e --fork.chain.height op=120000000,base=20000000",
+		},
+		&cli.Uint64Flag{
+			Name:  ForkTimestampFlagName,
+			Usage: "Unix timestamp to re-fork every chain to, using the latest block of each chain at or before this time. Cannot be combined with --" + L1ForkHeightFlagName,
+		},
+		&cli.BoolFlag{
+			Name:  ReplayTxsFlagName,
+			Usage: "Replay the transactions sent to the L2s, i.e local deployments, on top of the re-forked chains",
+		},
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// ExportCLIFlags are used to export the configuration of an already running instance for other tooling
+func ExportCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{
+			Name:     ExportFormatFlagName,
+			Usage:    "Format of the exported configuration. options: foundry (rpc_endpoints of a foundry.toml), hardhat (networks of a hardhat config), dotenv",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  ExportOutFlagName,
+			Usage: "File to write the exported configuration to. Written to stdout when not specified",
+		},
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// MessagesCLIFlags are used to list the interop messages of an already running instance
+func MessagesCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  MessageStatusFlagName,
+			Usage: "Only list messages with the status. options: sent, relayed, failed",
+		},
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// CrossDomainMessagesCLIFlags are used to list the L1<>L2 messages of an already running instance
+func CrossDomainMessagesCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  MessageStatusFlagName,
+			Usage: "Only list messages with the status. options: sent, relayed, failed, replayed",
+		},
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// CrossDomainMessageCLIFlags are used to show or replay an L1<>L2 message of an already running instance
+func CrossDomainMessageCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  ChainFlagName,
+			Usage: "Name or id of the L2 the message is sent to or from, when the same message is sent by several L2s",
+		},
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// SequencerPauseCLIFlags are used to pause the sequencer of an L2 of an already running instance
+func SequencerPauseCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		sequencerChainFlag(),
+		&cli.StringFlag{
+			Name:  SequencerModeFlagName,
+			Usage: "Handling of user transactions while paused. options: queue, reject",
+			Value: "queue",
+		},
+		&cli.Uint64Flag{
+			Name:  SequencingWindowFlagName,
+			Usage: "L1 blocks after which the queued transactions are dropped when resuming",
+			Value: DefaultSequencingWindow,
+		},
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// SequencerResumeCLIFlags are used to resume the sequencer of an L2 of an already running instance
+func SequencerResumeCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{sequencerChainFlag()}, AdminClientCLIFlags(envPrefix)...)
+}
+
+func sequencerChainFlag() cli.Flag {
+	return &cli.StringFlag{
+		Name:     ChainFlagName,
+		Usage:    "Name or id of the L2",
+		Required: true,
+	}
+}
+
+// RelayCLIFlags are used to relay an interop message of an already running instance
+func RelayCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{privateKeyFlag(envPrefix)}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// DepositCLIFlags are used to deposit ETH or an L1 token from the L1 to an L2 of an already running instance
+func DepositCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{
+			Name:     ChainFlagName,
+			Usage:    "Name or id of the L2 to deposit to",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     AmountFlagName,
+			Usage:    "Amount of ether to deposit, i.e 0.5, or whole tokens when --" + TokenFlagName + " is specified",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  TokenFlagName,
+			Usage: "Address, name or symbol of an L1 token of the running instance to deposit through the L1StandardBridge, rather than ETH",
+		},
+		&cli.StringFlag{
+			Name:  RecipientFlagName,
+			Usage: "Recipient of the deposit on the L2. Defaults to the sender",
+		},
+		privateKeyFlag(envPrefix),
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// FundCLIFlags are used to fund an address on the chains of an already running instance
+func FundCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{
+			Name:     AmountFlagName,
+			Usage:    "Balance to set, in ether or whole tokens when --" + TokenFlagName + " is specified",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  TokenFlagName,
+			Usage: "Address of the ERC20 to set the balance of, rather than ETH",
+		},
+		&cli.StringSliceFlag{
+			Name:  ChainFlagName,
+			Usage: "Names or ids of the chains to fund the address on. Every chain when not specified",
+		},
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// BridgeCLIFlags are used to send a SuperchainERC20 between the L2s of an already running instance
+func BridgeCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{
+			Name:     TokenFlagName,
+			Usage:    "Address of the SuperchainERC20, or the name of an interop predeploy such as SuperchainWETH",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     SourceFlagName,
+			Usage:    "Name or id of the L2 to send the tokens from",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     DestinationFlagName,
+			Usage:    "Name or id of the L2 to send the tokens to",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:     AmountFlagName,
+			Usage:    "Amount of whole tokens to send, i.e 0.5",
+			Required: true,
+		},
+		&cli.StringFlag{
+			Name:  SenderFlagName,
+			Usage: "Account sending the tokens, impersonated on the source chain. Defaults to the first dev account",
+		},
+		&cli.StringFlag{
+			Name:  RecipientFlagName,
+			Usage: "Recipient of the tokens on the destination chain. Defaults to the sender",
+		},
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+// DeployCLIFlags are used to deploy a contract to the same address on the chains of an already running instance
+func DeployCLIFlags(envPrefix string) []cli.Flag {
+	return append([]cli.Flag{
+		&cli.StringFlag{
+			Name:  BytecodeFlagName,
+			Usage: "Hex encoded creation bytecode of the contract",
+		},
+		&cli.StringFlag{
+			Name:  ArtifactFlagName,
+			Usage: "Path to the foundry artifact of the contract, i.e out/Counter.sol/Counter.json, rather than --" + BytecodeFlagName,
+		},
+		&cli.StringFlag{
+			Name:  ConstructorArgsFlagName,
+			Usage: "Hex encoded abi encoded constructor arguments, appended to the creation bytecode",
+		},
+		&cli.StringFlag{
+			Name:  SaltFlagName,
+			Usage: "Hex encoded CREATE2 salt of up to 32 bytes",
+			Value: "0x00",
+		},
+		&cli.BoolFlag{
+			Name:  DeployL1FlagName,
+			Usage: "Also deploy the contract to the L1",
+		},
+		&cli.StringFlag{
+			Name:  SenderFlagName,
+			Usage: "Account sending the factory transactions, impersonated on every chain. Defaults to the first dev account",
+		},
+	}, AdminClientCLIFlags(envPrefix)...)
+}
+
+func privateKeyFlag(envPrefix string) cli.Flag {
+	return &cli.StringFlag{
+		Name:    PrivateKeyFlagName,
+		Usage:   "Hex encoded private key of the sender. Defaults to the first dev account",
+		EnvVars: opservice.PrefixEnvVar(envPrefix, "PRIVATE_KEY"),
 	}
 }
 
@@ -75,17 +552,52 @@ func ForkCLIFlags(envPrefix string) []cli.Flag {
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_FORK_HEIGHT"),
 		},
 		&cli.StringSliceFlag{
-			Name:     ChainsFlagName,
-			Usage:    fmt.Sprintf("chains to fork in the superchain, mainnet options: [%s]. In order to replace the public rpc endpoint for a chain, specify the ($%s_RPC_URL_<CHAIN>) env variable. i.e SUPERSIM_RPC_URL_OP=http://optimism-mainnet.infura.io/v3/<API-KEY>", mainnetMembers, envPrefix),
-			Required: true,
-			EnvVars:  opservice.PrefixEnvVar(envPrefix, "CHAINS"),
+			Name:    ForkChainHeightFlagName,
+			Usage:   "L2 heights to fork chains at, instead of deriving them from the L1 fork height, specified as <chain>=<height>. i.e --fork.chain.height op=120000000,base=20000000",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "FORK_CHAIN_HEIGHT"),
+		},
+		&cli.Uint64Flag{
+			Name:    ForkTimestampFlagName,
+			Usage:   "Unix timestamp to fork every chain at, using the latest block of each chain at or before this time. Cannot be combined with --" + L1ForkHeightFlagName,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "FORK_TIMESTAMP"),
+		},
+		&cli.StringFlag{
+			Name:    L1ForkURLFlagName,
+			Usage:   "L1 rpc url to fork. Required when the network is not in the superchain registry, i.e a local devnet L1",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "L1_FORK_URL"),
+		},
+		&cli.StringFlag{
+			Name:    ForkRecordFlagName,
+			Usage:   "Directory to record all upstream rpc responses of the fork session to, for later replay with --" + ForkReplayFlagName,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "FORK_RECORD"),
+		},
+		&cli.StringFlag{
+			Name:    ForkReplayFlagName,
+			Usage:   "Directory of upstream rpc responses, recorded with --" + ForkRecordFlagName + ", to replay the fork session offline",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "FORK_REPLAY"),
+		},
+		&cli.StringSliceFlag{
+			Name:    ChainsFlagName,
+			Usage:   fmt.Sprintf("chains to fork in the superchain, mainnet options: [%s]. In order to replace the public rpc endpoint for a chain, specify the ($%s_RPC_URL_<CHAIN>) env variable. i.e SUPERSIM_RPC_URL_OP=http://optimism-mainnet.infura.io/v3/<API-KEY>", mainnetMembers, envPrefix),
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "CHAINS"),
+		},
+		&cli.StringSliceFlag{
+			Name:    CustomChainsFlagName,
+			Usage:   "paths to config files of OP Stack chains, not in the superchain registry, to fork. Each file specifies the chain `name`, `rpcUrl` and either its L1 `addresses` or a path to its `rollupConfig`",
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "CUSTOM_CHAINS"),
 		},
 		&cli.StringFlag{
 			Name:    NetworkFlagName,
 			Value:   "mainnet",
-			Usage:   fmt.Sprintf("superchain network. options: %s. In order to replace the public rpc endpoint for the network, specify the ($%s_RPC_URL_<NETWORK>) env variable. i.e SUPERSIM_RPC_URL_MAINNET=http://mainnet.infura.io/v3/<API-KEY>", networks, envPrefix),
+			Usage:   fmt.Sprintf("superchain network. options: %s. In order to replace the public rpc endpoint for the network, specify the ($%s_RPC_URL_<NETWORK>) env variable. i.e SUPERSIM_RPC_URL_MAINNET=http://mainnet.infura.io/v3/<API-KEY>. Any other name can be used with --%s to fork a network outside of the registry", networks, envPrefix, L1ForkURLFlagName),
 			EnvVars: opservice.PrefixEnvVar(envPrefix, "NETWORK"),
 		},
+		&cli.Uint64Flag{
+			Name:    LocalChainsFlagName,
+			Usage:   fmt.Sprintf("number of local L2 chains, started from the generated genesis, to run alongside the forked chains. max: %d", len(genesis.GeneratedGenesisDeployment.L2s)),
+			Value:   0,
+			EnvVars: opservice.PrefixEnvVar(envPrefix, "LOCAL_CHAINS"),
+		},
 		&cli.BoolFlag{
 			Name:    InteropEnabledFlagName,
 			Value:   true, // enabled by default
@@ -95,11 +607,133 @@ func ForkCLIFlags(envPrefix string) []cli.Flag {
 	}
 }
 
+// GenesisCLIConfig configures a genesis other than the one embedded in supersim. Either a
+// pre-generated bundle is loaded from `Dir` or a genesis is generated from the recipe.
+type GenesisCLIConfig struct {
+	Dir string
+
+	L1ChainID        uint64
+	L2ChainIDs       []uint64
+	GenesisTimestamp uint64
+
+	MonorepoArtifacts  string
+	PeripheryArtifacts string
+	CacheDir           string
+
+	// Optional hardfork schedule of the generated L2s
+	Hardfork            string
+	HardforkActivations []string
+
+	// User contracts & balances included in every generated L2 genesis
+	UserArtifacts string
+	UserScript    string
+	UserAllocs    string
+}
+
+// HardforkSchedule returns the hardfork schedule of the generated L2s, nil if not specified
+func (c *GenesisCLIConfig) HardforkSchedule() (*worldgen.HardforkSchedule, error) {
+	if c.Hardfork == "" {
+		return nil, nil
+	}
+
+	activations, err := worldgen.ParseHardforkActivations(c.HardforkActivations)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule := &worldgen.HardforkSchedule{Genesis: c.Hardfork, Activations: activations}
+	if err := schedule.Check(); err != nil {
+		return nil, err
+	}
+	return schedule, nil
+}
+
+// UserGenesis returns the user contracts & balances included in the generated genesis, nil if not specified
+func (c *GenesisCLIConfig) UserGenesis() (*worldgen.UserGenesis, error) {
+	if c.UserScript == "" && c.UserAllocs == "" {
+		return nil, nil
+	}
+
+	userGenesis := &worldgen.UserGenesis{Script: c.UserScript, AllocsPath: c.UserAllocs}
+	if c.UserArtifacts != "" {
+		url, err := worldgen.ParseLocalPathOrURL(c.UserArtifacts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse user artifacts url: %w", err)
+		}
+		userGenesis.ArtifactsURL = url
+	}
+	if err := userGenesis.Check(); err != nil {
+		return nil, err
+	}
+	return userGenesis, nil
+}
+
+// Recipe returns the recipe of the genesis to generate, validated with `worldgen.CheckRecipe`. When the
+// L2 chain ids are not specified with a user genesis, they are the chains of per-chain user allocs, or
+// otherwise the L2s run by default
+func (c *GenesisCLIConfig) Recipe() (*worldgen.Recipe, error) {
+	hardforks, err := c.HardforkSchedule()
+	if err != nil {
+		return nil, err
+	}
+	userGenesis, err := c.UserGenesis()
+	if err != nil {
+		return nil, err
+	}
+
+	l2ChainIDs := c.L2ChainIDs
+	if userGenesis != nil && userGenesis.AllocsPath != "" {
+		userAllocs, err := worldgen.LoadUserAllocs(userGenesis.AllocsPath)
+		if err != nil {
+			return nil, err
+		}
+		if len(l2ChainIDs) == 0 {
+			l2ChainIDs = userAllocs.ChainIDs()
+		} else if err := userAllocs.Check(l2ChainIDs); err != nil {
+			return nil, err
+		}
+	}
+	if len(l2ChainIDs) == 0 && userGenesis != nil {
+		l2ChainIDs = defaultL2ChainIDs()
+	}
+
+	recipe := &worldgen.Recipe{
+		InteropDevRecipe: interopgen.InteropDevRecipe{
+			L1ChainID:        c.L1ChainID,
+			L2ChainIDs:       l2ChainIDs,
+			GenesisTimestamp: c.GenesisTimestamp,
+		},
+		Hardforks: hardforks,
+	}
+	if err := worldgen.CheckRecipe(recipe); err != nil {
+		return nil, err
+	}
+	return recipe, nil
+}
+
 type ForkCLIConfig struct {
 	L1ForkHeight uint64
+	L1ForkURL    string
 	Network      string
 	Chains       []string
 
+	// Explicit L2 fork heights by chain name
+	L2ForkHeights map[string]uint64
+
+	// Forks every chain at this timestamp rather than a block height
+	ForkTimestamp uint64
+
+	// Chains outside of the superchain registry
+	CustomChains []*CustomChainConfig
+
+	// Directories to record or replay upstream rpc responses
+	RecordDir string
+	ReplayDir string
+
+	// Number of local chains from the generated
+	// genesis to run alongside the forked chains
+	LocalChains uint64
+
 	InteropEnabled bool
 }
 
@@ -111,9 +745,39 @@ type CLIConfig struct {
 
 	InteropAutoRelay bool
 
+	// Tokens deployed at the same address on every L2
+	SuperchainERC20s []Token
+
+	// Tokens deployed to the L1, bridged to an OptimismMintableERC20 on every L2
+	L1Tokens []Token
+
+	// Seconds between output proposals of the local L2s, disabled when zero
+	ProposerInterval uint64
+
 	LogsDirectory string
 
-	ForkConfig *ForkCLIConfig
+	// Optional file the JSON configuration document is written to on startup
+	ConfigOut string
+
+	// Mining configuration. Zero values retain the defaults of the network
+	L1BlockTime  uint64
+	L1MiningMode MiningMode
+	L2BlockTime  uint64
+	L2MiningMode MiningMode
+
+	// Per-chain overrides keyed by chain name or chain id
+	ChainBlockTimes  map[string]uint64
+	ChainMiningModes map[string]MiningMode
+
+	// Optional. Replaces the default secrets config of every chain
+	SecretsConfig *SecretsConfig
+
+	// Accounts funded on every chain, and per chain keyed by chain name or chain id
+	FundedAccounts      []FundedAccount
+	ChainFundedAccounts map[string][]FundedAccount
+
+	GenesisConfig *GenesisCLIConfig
+	ForkConfig    *ForkCLIConfig
 }
 
 func ReadCLIConfig(ctx *cli.Context) (*CLIConfig, error) {
@@ -125,17 +789,124 @@ func ReadCLIConfig(ctx *cli.Context) (*CLIConfig, error) {
 
 		InteropAutoRelay: ctx.Bool(InteropAutoRelayFlagName),
 
+		ProposerInterval: ctx.Uint64(ProposerIntervalFlagName),
+
 		LogsDirectory: ctx.String(LogsDirectoryFlagName),
+		ConfigOut:     ctx.String(ConfigOutFlagName),
+
+		L1BlockTime:  ctx.Uint64(L1BlockTimeFlagName),
+		L1MiningMode: MiningMode(ctx.String(L1MiningModeFlagName)),
+		L2BlockTime:  ctx.Uint64(L2BlockTimeFlagName),
+		L2MiningMode: MiningMode(ctx.String(L2MiningModeFlagName)),
+
+		ChainBlockTimes:  make(map[string]uint64),
+		ChainMiningModes: make(map[string]MiningMode),
+
+		ChainFundedAccounts: make(map[string][]FundedAccount),
+	}
+
+	if ctx.IsSet(AccountsMnemonicFlagName) || ctx.IsSet(AccountsDerivationPathFlagName) || ctx.IsSet(AccountsCountFlagName) {
+		derivationPath, err := accounts.ParseDerivationPath(ctx.String(AccountsDerivationPathFlagName))
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", AccountsDerivationPathFlagName, err)
+		}
+		cfg.SecretsConfig = &SecretsConfig{
+			Accounts:       ctx.Uint64(AccountsCountFlagName),
+			Mnemonic:       ctx.String(AccountsMnemonicFlagName),
+			DerivationPath: derivationPath,
+		}
+	}
+	for _, value := range ctx.StringSlice(FundFlagName) {
+		chain, account, err := ParseFundedAccount(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", FundFlagName, err)
+		}
+		if chain == "" {
+			cfg.FundedAccounts = append(cfg.FundedAccounts, account)
+		} else {
+			cfg.ChainFundedAccounts[chain] = append(cfg.ChainFundedAccounts[chain], account)
+		}
+	}
+
+	superchainERC20s, err := readTokens(ctx, InteropTokenFlagName, InteropTokenBalanceFlagName)
+	if err != nil {
+		return nil, err
+	}
+	l1Tokens, err := readTokens(ctx, L1TokenFlagName, L1TokenBalanceFlagName)
+	if err != nil {
+		return nil, err
+	}
+	cfg.SuperchainERC20s, cfg.L1Tokens = superchainERC20s, l1Tokens
+
+	for _, override := range ctx.StringSlice(ChainBlockTimeFlagName) {
+		chain, value, err := parseChainOverride(override)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", ChainBlockTimeFlagName, err)
+		}
+		blockTime, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s block time for chain %s: %w", ChainBlockTimeFlagName, chain, err)
+		}
+		cfg.ChainBlockTimes[chain] = blockTime
+	}
+	for _, override := range ctx.StringSlice(ChainMiningModeFlagName) {
+		chain, value, err := parseChainOverride(override)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", ChainMiningModeFlagName, err)
+		}
+		cfg.ChainMiningModes[chain] = MiningMode(value)
+	}
+
+	userGenesisSet := ctx.IsSet(GenesisUserArtifactsFlagName) || ctx.IsSet(GenesisUserScriptFlagName) || ctx.IsSet(GenesisUserAllocsFlagName)
+	hardforkSet := ctx.IsSet(GenesisHardforkFlagName) || ctx.IsSet(GenesisHardforkActivationsFlagName)
+	if ctx.IsSet(GenesisDirFlagName) || ctx.IsSet(GenesisL2ChainIDsFlagName) || userGenesisSet || hardforkSet {
+		cfg.GenesisConfig = &GenesisCLIConfig{
+			Dir:                ctx.String(GenesisDirFlagName),
+			L1ChainID:          ctx.Uint64(GenesisL1ChainIDFlagName),
+			L2ChainIDs:         ctx.Uint64Slice(GenesisL2ChainIDsFlagName),
+			GenesisTimestamp:   ctx.Uint64(GenesisTimestampFlagName),
+			MonorepoArtifacts:  ctx.String(GenesisMonorepoArtifactsFlagName),
+			PeripheryArtifacts: ctx.String(GenesisPeripheryArtifactsFlagName),
+			CacheDir:           ctx.String(GenesisCacheDirFlagName),
+			UserArtifacts:      ctx.String(GenesisUserArtifactsFlagName),
+			UserScript:         ctx.String(GenesisUserScriptFlagName),
+			UserAllocs:         ctx.String(GenesisUserAllocsFlagName),
+		}
+		if hardforkSet {
+			cfg.GenesisConfig.Hardfork = strings.ToLower(ctx.String(GenesisHardforkFlagName))
+			cfg.GenesisConfig.HardforkActivations = ctx.StringSlice(GenesisHardforkActivationsFlagName)
+		}
 	}
 
 	if ctx.Command.Name == ForkCommandName {
 		cfg.ForkConfig = &ForkCLIConfig{
 			L1ForkHeight: ctx.Uint64(L1ForkHeightFlagName),
+			L1ForkURL:    ctx.String(L1ForkURLFlagName),
 			Network:      ctx.String(NetworkFlagName),
 			Chains:       ctx.StringSlice(ChainsFlagName),
+			LocalChains:  ctx.Uint64(LocalChainsFlagName),
+
+			ForkTimestamp: ctx.Uint64(ForkTimestampFlagName),
+
+			RecordDir: ctx.String(ForkRecordFlagName),
+			ReplayDir: ctx.String(ForkReplayFlagName),
 
 			InteropEnabled: ctx.Bool(InteropEnabledFlagName),
 		}
+
+		l2ForkHeights, err := ReadChainForkHeights(ctx)
+		if err != nil {
+			return nil, err
+		}
+		cfg.ForkConfig.L2ForkHeights = l2ForkHeights
+
+		for _, path := range ctx.StringSlice(CustomChainsFlagName) {
+			customChain, err := LoadCustomChainConfig(path)
+			if err != nil {
+				return nil, fmt.Errorf("invalid custom chain %s: %w", path, err)
+			}
+			cfg.ForkConfig.CustomChains = append(cfg.ForkConfig.CustomChains, customChain)
+		}
 	}
 
 	return cfg, cfg.Check()
@@ -143,16 +914,91 @@ func ReadCLIConfig(ctx *cli.Context) (*CLIConfig, error) {
 
 // Check runs validatation on the cli configuration
 func (c *CLIConfig) Check() error {
+	for _, mode := range []MiningMode{c.L1MiningMode, c.L2MiningMode} {
+		if mode != "" && !mode.IsValid() {
+			return fmt.Errorf("unrecognized mining mode `%s`, available modes: [%s]", mode, miningModesString())
+		}
+	}
+	for chain, mode := range c.ChainMiningModes {
+		if !mode.IsValid() {
+			return fmt.Errorf("unrecognized mining mode `%s` for chain %s, available modes: [%s]", mode, chain, miningModesString())
+		}
+	}
+	for chain, blockTime := range c.ChainBlockTimes {
+		if blockTime == 0 {
+			return fmt.Errorf("block time for chain %s must be greater than zero", chain)
+		}
+	}
+	if err := checkTokens(c.SuperchainERC20s); err != nil {
+		return err
+	}
+	if err := checkTokens(c.L1Tokens); err != nil {
+		return err
+	}
+	if len(c.SuperchainERC20s) > 0 && c.ForkConfig != nil && !c.ForkConfig.InteropEnabled {
+		return fmt.Errorf("--%s requires interop, enable it with --%s", InteropTokenFlagName, InteropEnabledFlagName)
+	}
+
+	if c.SecretsConfig != nil {
+		if err := c.SecretsConfig.Check(); err != nil {
+			return fmt.Errorf("invalid accounts config: %w", err)
+		}
+	}
+
+	if c.GenesisConfig != nil {
+		genesisCfg := c.GenesisConfig
+		if c.ForkConfig != nil {
+			return fmt.Errorf("a custom genesis cannot be used in fork mode")
+		}
+		if genesisCfg.Dir != "" && len(genesisCfg.L2ChainIDs) > 0 {
+			return fmt.Errorf("--%s and --%s are mutually exclusive", GenesisDirFlagName, GenesisL2ChainIDsFlagName)
+		}
+		if (genesisCfg.UserArtifacts == "") != (genesisCfg.UserScript == "") {
+			return fmt.Errorf("--%s and --%s must be specified together", GenesisUserArtifactsFlagName, GenesisUserScriptFlagName)
+		}
+		if genesisCfg.Dir != "" && (genesisCfg.UserScript != "" || genesisCfg.UserAllocs != "") {
+			return fmt.Errorf("user contracts & allocs are only included in a generated genesis, not with --%s", GenesisDirFlagName)
+		}
+		if genesisCfg.Dir != "" && genesisCfg.Hardfork != "" {
+			return fmt.Errorf("the hardfork schedule only applies to a generated genesis, not with --%s", GenesisDirFlagName)
+		}
+		if genesisCfg.Dir == "" {
+			if _, err := genesisCfg.Recipe(); err != nil {
+				return fmt.Errorf("invalid genesis recipe: %w", err)
+			}
+		}
+	}
+
 	if c.ForkConfig != nil {
 		forkCfg := c.ForkConfig
 
+		if forkCfg.RecordDir != "" && forkCfg.ReplayDir != "" {
+			return fmt.Errorf("--%s and --%s are mutually exclusive", ForkRecordFlagName, ForkReplayFlagName)
+		}
+		if forkCfg.ForkTimestamp > 0 && forkCfg.L1ForkHeight > 0 {
+			return fmt.Errorf("--%s and --%s are mutually exclusive", ForkTimestampFlagName, L1ForkHeightFlagName)
+		}
+		for chain := range forkCfg.L2ForkHeights {
+			if !slices.Contains(forkCfg.Chains, chain) {
+				return fmt.Errorf("--%s specified for chain `%s` which is not forked", ForkChainHeightFlagName, chain)
+			}
+		}
+
 		superchain, ok := registry.Superchains[forkCfg.Network]
 		if !ok {
-			return fmt.Errorf("unrecognized superchain network `%s`, available networks: [%s]",
-				forkCfg.Network, strings.Join(superchainNetworks(), ", "))
+			if forkCfg.L1ForkURL == "" {
+				return fmt.Errorf("unrecognized superchain network `%s`, available networks: [%s]. Specify --%s to fork a network outside of the registry",
+					forkCfg.Network, strings.Join(superchainNetworks(), ", "), L1ForkURLFlagName)
+			}
+			if len(forkCfg.Chains) > 0 {
+				return fmt.Errorf("registry chains cannot be forked from network `%s` outside of the registry, use --%s instead", forkCfg.Network, CustomChainsFlagName)
+			}
 		}
 
-		if len(forkCfg.Chains) == 0 {
+		if len(forkCfg.Chains) == 0 && len(forkCfg.CustomChains) == 0 {
+			if superchain == nil {
+				return fmt.Errorf("no chains specified! specify --%s", CustomChainsFlagName)
+			}
 			return fmt.Errorf("no chains specified! available chains: [%s]", strings.Join(superchainMemberChains(superchain), ","))
 		}
 
@@ -163,7 +1009,215 @@ func (c *CLIConfig) Check() error {
 					chain, forkCfg.Network, strings.Join(superchainMemberChains(superchain), ", "))
 			}
 		}
+
+		// custom chains must be uniquely named
+		names := make(map[string]bool)
+		for _, chain := range forkCfg.Chains {
+			names[chain] = true
+		}
+		for _, customChain := range forkCfg.CustomChains {
+			if err := customChain.Check(); err != nil {
+				return err
+			}
+			if names[customChain.Name] {
+				return fmt.Errorf("duplicate chain name `%s`", customChain.Name)
+			}
+			names[customChain.Name] = true
+		}
+
+		if forkCfg.LocalChains > uint64(len(genesis.GeneratedGenesisDeployment.L2s)) {
+			return fmt.Errorf("too many local chains requested: %d, max: %d", forkCfg.LocalChains, len(genesis.GeneratedGenesisDeployment.L2s))
+		}
+
+		// local chains are allocated from the generated genesis and cannot share an id with a forked chain
+		for i := range int(forkCfg.LocalChains) {
+			chainID := genesis.GeneratedGenesisDeployment.L2s[i].ChainID
+			for _, chain := range forkCfg.Chains {
+				if OPChainByName(superchain, chain).ChainID == chainID {
+					return fmt.Errorf("local chain id %d conflicts with forked chain `%s`", chainID, chain)
+				}
+			}
+			for _, customChain := range forkCfg.CustomChains {
+				if customChain.ChainID == chainID {
+					return fmt.Errorf("local chain id %d conflicts with forked chain `%s`", chainID, customChain.Name)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ApplyMiningConfig forwards the mining configuration onto every chain in the network. Per-chain
+// overrides take precedence over the L1 & L2 wide settings.
+func (c *CLIConfig) ApplyMiningConfig(networkConfig *NetworkConfig) error {
+	if err := checkChainKeys(networkConfig, ChainBlockTimeFlagName, c.ChainBlockTimes); err != nil {
+		return err
+	}
+	if err := checkChainKeys(networkConfig, ChainMiningModeFlagName, c.ChainMiningModes); err != nil {
+		return err
+	}
+
+	if c.L1BlockTime > 0 {
+		networkConfig.L1Config.BlockTime = c.L1BlockTime
+	}
+	if c.L1MiningMode != "" {
+		networkConfig.L1Config.MiningMode = c.L1MiningMode
+	}
+	c.applyChainMiningOverrides(&networkConfig.L1Config)
+
+	for i := range networkConfig.L2Configs {
+		chainCfg := &networkConfig.L2Configs[i]
+		if c.L2BlockTime > 0 {
+			chainCfg.BlockTime = c.L2BlockTime
+		}
+		if c.L2MiningMode != "" {
+			chainCfg.MiningMode = c.L2MiningMode
+		}
+		c.applyChainMiningOverrides(chainCfg)
+	}
+	return nil
+}
+
+func (c *CLIConfig) applyChainMiningOverrides(chainCfg *ChainConfig) {
+	for _, key := range chainKeys(chainCfg) {
+		if blockTime, ok := c.ChainBlockTimes[key]; ok {
+			chainCfg.BlockTime = blockTime
+		}
+		if mode, ok := c.ChainMiningModes[key]; ok {
+			chainCfg.MiningMode = mode
+		}
 	}
+}
 
+// chainKeys are the keys a per-chain flag entry can reference the chain by, its name or chain id
+func chainKeys(chainCfg *ChainConfig) []string {
+	return []string{chainCfg.Name, strconv.FormatUint(chainCfg.ChainID, 10)}
+}
+
+// checkChainKeys errors on per-chain flag entries that reference no chain of the network, which
+// would otherwise be silently ignored
+func checkChainKeys[V any](networkConfig *NetworkConfig, flagName string, entries map[string]V) error {
+	known := make(map[string]bool)
+	for _, key := range chainKeys(&networkConfig.L1Config) {
+		known[key] = true
+	}
+	for i := range networkConfig.L2Configs {
+		for _, key := range chainKeys(&networkConfig.L2Configs[i]) {
+			known[key] = true
+		}
+	}
+
+	for key := range entries {
+		if !known[key] {
+			return fmt.Errorf("invalid --%s: unknown chain `%s`, expected the name or chain id of a chain in the network", flagName, key)
+		}
+	}
+	return nil
+}
+
+// ApplyAccountsConfig replaces the secrets config of every chain and sets the accounts funded on startup
+func (c *CLIConfig) ApplyAccountsConfig(networkConfig *NetworkConfig) error {
+	if err := checkChainKeys(networkConfig, FundFlagName, c.ChainFundedAccounts); err != nil {
+		return err
+	}
+
+	chainCfgs := []*ChainConfig{&networkConfig.L1Config}
+	for i := range networkConfig.L2Configs {
+		chainCfgs = append(chainCfgs, &networkConfig.L2Configs[i])
+	}
+
+	// The genesis only funds the default accounts
+	var devAccounts []FundedAccount
+	if c.SecretsConfig != nil && !c.SecretsConfig.IsDefault() {
+		for _, account := range c.SecretsConfig.DevAccounts() {
+			devAccounts = append(devAccounts, FundedAccount{Address: account.Address, Amount: DefaultDevAccountBalance})
+		}
+	}
+
+	for _, chainCfg := range chainCfgs {
+		if c.SecretsConfig != nil {
+			chainCfg.SecretsConfig = *c.SecretsConfig
+		}
+
+		chainCfg.FundedAccounts = append(chainCfg.FundedAccounts, devAccounts...)
+		chainCfg.FundedAccounts = append(chainCfg.FundedAccounts, c.FundedAccounts...)
+		for _, key := range chainKeys(chainCfg) {
+			chainCfg.FundedAccounts = append(chainCfg.FundedAccounts, c.ChainFundedAccounts[key]...)
+		}
+	}
+	return nil
+}
+
+// parseChainOverride splits a `<chain>=<value>` flag entry
+func parseChainOverride(override string) (string, string, error) {
+	chain, value, ok := strings.Cut(override, "=")
+	if !ok || chain == "" || value == "" {
+		return "", "", fmt.Errorf("expected <chain>=<value>, got `%s`", override)
+	}
+	return chain, value, nil
+}
+
+// ReadChainForkHeights parses the L2 fork heights of the --fork.chain.height flag by chain name
+func ReadChainForkHeights(ctx *cli.Context) (map[string]uint64, error) {
+	heights := make(map[string]uint64)
+	for _, override := range ctx.StringSlice(ForkChainHeightFlagName) {
+		chain, value, err := parseChainOverride(override)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", ForkChainHeightFlagName, err)
+		}
+		height, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s height for chain %s: %w", ForkChainHeightFlagName, chain, err)
+		}
+		heights[chain] = height
+	}
+	return heights, nil
+}
+
+func miningModesString() string {
+	modes := make([]string, len(MiningModes))
+	for i, mode := range MiningModes {
+		modes[i] = string(mode)
+	}
+	return strings.Join(modes, ", ")
+}
+
+// readTokens parses the tokens of the flag, attaching the balances of the balance flag by symbol
+func readTokens(ctx *cli.Context, tokenFlagName, balanceFlagName string) ([]Token, error) {
+	var tokens []Token
+	for _, value := range ctx.StringSlice(tokenFlagName) {
+		token, err := ParseToken(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", tokenFlagName, err)
+		}
+		tokens = append(tokens, token)
+	}
+	for _, value := range ctx.StringSlice(balanceFlagName) {
+		symbol, balance, err := ParseTokenBalance(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", balanceFlagName, err)
+		}
+
+		i := slices.IndexFunc(tokens, func(token Token) bool { return token.Symbol == symbol })
+		if i < 0 {
+			return nil, fmt.Errorf("invalid --%s: no --%s with symbol `%s`", balanceFlagName, tokenFlagName, symbol)
+		}
+		tokens[i].Balances = append(tokens[i].Balances, balance)
+	}
+	return tokens, nil
+}
+
+func checkTokens(tokens []Token) error {
+	symbols := make(map[string]bool)
+	for _, token := range tokens {
+		if err := token.Check(); err != nil {
+			return fmt.Errorf("invalid token %s: %w", token.Symbol, err)
+		}
+		if symbols[token.Symbol] {
+			return fmt.Errorf("duplicate token symbol `%s`", token.Symbol)
+		}
+		symbols[token.Symbol] = true
+	}
 	return nil
 }
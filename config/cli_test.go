@@ -0,0 +1,131 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyMiningConfig(t *testing.T) {
+	networkConfig := GetDefaultNetworkConfig(0, "")
+	cliConfig := &CLIConfig{
+		L1BlockTime:      12,
+		L2MiningMode:     AutomineMiningMode,
+		ChainBlockTimes:  map[string]uint64{"902": 1},
+		ChainMiningModes: map[string]MiningMode{"OPChainB": IntervalMiningMode},
+	}
+	require.NoError(t, cliConfig.Check())
+
+	require.NoError(t, cliConfig.ApplyMiningConfig(&networkConfig))
+
+	require.Equal(t, uint64(12), networkConfig.L1Config.BlockTime)
+	require.Equal(t, IntervalMiningMode, networkConfig.L1Config.MiningMode)
+
+	// L2 wide settings
+	require.Equal(t, uint64(DefaultBlockTime), networkConfig.L2Configs[0].BlockTime)
+	require.Equal(t, AutomineMiningMode, networkConfig.L2Configs[0].MiningMode)
+
+	// per-chain overrides by id & name
+	require.Equal(t, uint64(1), networkConfig.L2Configs[1].BlockTime)
+	require.Equal(t, IntervalMiningMode, networkConfig.L2Configs[1].MiningMode)
+}
+
+func TestApplyMiningConfigUnknownChain(t *testing.T) {
+	networkConfig := GetDefaultNetworkConfig(0, "")
+	require.Error(t, (&CLIConfig{ChainBlockTimes: map[string]uint64{"OPChainZ": 1}}).ApplyMiningConfig(&networkConfig))
+	require.Error(t, (&CLIConfig{ChainMiningModes: map[string]MiningMode{"999": IntervalMiningMode}}).ApplyMiningConfig(&networkConfig))
+
+	// the l1 can be referenced by name or id
+	require.NoError(t, (&CLIConfig{ChainBlockTimes: map[string]uint64{"900": 1}}).ApplyMiningConfig(&networkConfig))
+	require.NoError(t, (&CLIConfig{ChainBlockTimes: map[string]uint64{networkConfig.L1Config.Name: 1}}).ApplyMiningConfig(&networkConfig))
+}
+
+func TestCheckMiningConfig(t *testing.T) {
+	require.Error(t, (&CLIConfig{L1MiningMode: "instant"}).Check())
+	require.Error(t, (&CLIConfig{ChainMiningModes: map[string]MiningMode{"901": "instant"}}).Check())
+	require.Error(t, (&CLIConfig{ChainBlockTimes: map[string]uint64{"901": 0}}).Check())
+}
+
+func TestCheckForkHeights(t *testing.T) {
+	forkConfig := func() *ForkCLIConfig {
+		return &ForkCLIConfig{Network: "mainnet", Chains: []string{"op"}, L2ForkHeights: map[string]uint64{}}
+	}
+
+	cfg := forkConfig()
+	cfg.L2ForkHeights["op"] = 100
+	cfg.ForkTimestamp = 1700000000
+	require.NoError(t, (&CLIConfig{ForkConfig: cfg}).Check())
+
+	cfg = forkConfig()
+	cfg.L2ForkHeights["base"] = 100
+	require.Error(t, (&CLIConfig{ForkConfig: cfg}).Check())
+
+	cfg = forkConfig()
+	cfg.L1ForkHeight = 100
+	cfg.ForkTimestamp = 1700000000
+	require.Error(t, (&CLIConfig{ForkConfig: cfg}).Check())
+}
+
+func TestCheckGenesisConfig(t *testing.T) {
+	require.NoError(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10, 8453}}}).Check())
+	require.NoError(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{Dir: "./genesis"}}).Check())
+
+	// chain ids must be unique
+	require.Error(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10, 10}}}).Check())
+	require.Error(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 10, L2ChainIDs: []uint64{10}}}).Check())
+	require.Error(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 0, L2ChainIDs: []uint64{10}}}).Check())
+
+	// either a bundle or a recipe
+	require.Error(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{Dir: "./genesis", L1ChainID: 1, L2ChainIDs: []uint64{10}}}).Check())
+
+	// user contracts are only included in a generated genesis
+	allocsPath := filepath.Join(t.TempDir(), "allocs.json")
+	require.NoError(t, os.WriteFile(allocsPath, []byte(`{"0x0000000000000000000000000000000000000001":{"balance":"0x1"}}`), 0o644))
+	require.NoError(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10}, UserArtifacts: "./out", UserScript: "Deploy.s.sol"}}).Check())
+	require.NoError(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10}, UserAllocs: allocsPath}}).Check())
+	require.Error(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10}, UserAllocs: "./missing.json"}}).Check())
+	require.Error(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10}, UserScript: "Deploy.s.sol"}}).Check())
+	require.Error(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{Dir: "./genesis", UserAllocs: "./allocs.json"}}).Check())
+
+	// hardfork schedule of a generated genesis
+	require.NoError(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10}, Hardfork: "granite", HardforkActivations: []string{"holocene=60"}}}).Check())
+	require.Error(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10}, Hardfork: "isthmus"}}).Check())
+	require.Error(t, (&CLIConfig{GenesisConfig: &GenesisCLIConfig{Dir: "./genesis", Hardfork: "granite"}}).Check())
+}
+
+func TestGenesisRecipeL2ChainIDs(t *testing.T) {
+	dir := t.TempDir()
+	sharedAllocsPath := filepath.Join(dir, "allocs.json")
+	require.NoError(t, os.WriteFile(sharedAllocsPath, []byte(`{"0x0000000000000000000000000000000000000001":{"balance":"0x1"}}`), 0o644))
+	chainAllocsPath := filepath.Join(dir, "chain-allocs.json")
+	require.NoError(t, os.WriteFile(chainAllocsPath, []byte(`{"8453":{},"10":{"0x0000000000000000000000000000000000000001":{"balance":"0x1"}}}`), 0o644))
+
+	// explicit chain ids
+	recipe, err := (&GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10}, UserAllocs: sharedAllocsPath}).Recipe()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10}, recipe.L2ChainIDs)
+
+	// derived from per-chain allocs
+	recipe, err = (&GenesisCLIConfig{L1ChainID: 1, UserAllocs: chainAllocsPath}).Recipe()
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 8453}, recipe.L2ChainIDs)
+
+	// the L2s run by default with shared allocs or a script
+	recipe, err = (&GenesisCLIConfig{L1ChainID: 1, UserAllocs: sharedAllocsPath}).Recipe()
+	require.NoError(t, err)
+	require.Equal(t, defaultL2ChainIDs(), recipe.L2ChainIDs)
+
+	recipe, err = (&GenesisCLIConfig{L1ChainID: 1, UserArtifacts: "./out", UserScript: "Deploy.s.sol"}).Recipe()
+	require.NoError(t, err)
+	require.Equal(t, defaultL2ChainIDs(), recipe.L2ChainIDs)
+
+	// per-chain allocs of a chain that is not generated
+	_, err = (&GenesisCLIConfig{L1ChainID: 1, L2ChainIDs: []uint64{10}, UserAllocs: chainAllocsPath}).Recipe()
+	require.Error(t, err)
+
+	// chain ids are required without a user genesis
+	_, err = (&GenesisCLIConfig{L1ChainID: 1}).Recipe()
+	require.Error(t, err)
+}
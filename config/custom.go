@@ -0,0 +1,119 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+	registry "github.com/ethereum-optimism/superchain-registry/superchain"
+)
+
+// CustomChainConfig describes an OP Stack chain that is not a member of the superchain registry.
+//
+// The chain is specified with its rpc url and either a set of L1 addresses or the path to the
+// op-node rollup config of the chain. When a rollup config is provided, the chain id, block time,
+// genesis time & L1 system addresses are read from it. Any L1 addresses which are not specified
+// are resolved from the SystemConfig contract on L1.
+type CustomChainConfig struct {
+	Name   string `json:"name"`
+	RPCUrl string `json:"rpcUrl"`
+
+	// Relative paths are resolved from the directory of the chain config file
+	RollupConfigPath string `json:"rollupConfig,omitempty"`
+
+	ChainID       uint64 `json:"chainId,omitempty"`
+	L1ChainID     uint64 `json:"l1ChainId,omitempty"`
+	BlockTime     uint64 `json:"blockTime,omitempty"`
+	GenesisL2Time uint64 `json:"genesisL2Time,omitempty"`
+
+	// Explicit height to fork the chain at, overriding the height derived from L1
+	ForkHeight *uint64 `json:"forkHeight,omitempty"`
+
+	Addresses *registry.AddressList `json:"addresses,omitempty"`
+}
+
+// LoadCustomChainConfig reads the chain config at the specified path, merging
+// in the fields of the referenced rollup config if present.
+func LoadCustomChainConfig(path string) (*CustomChainConfig, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read chain config: %w", err)
+	}
+
+	var cfg CustomChainConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal chain config %s: %w", path, err)
+	}
+	if cfg.Addresses == nil {
+		cfg.Addresses = &registry.AddressList{}
+	}
+
+	if cfg.RollupConfigPath != "" {
+		rollupConfigPath := cfg.RollupConfigPath
+		if !filepath.IsAbs(rollupConfigPath) {
+			rollupConfigPath = filepath.Join(filepath.Dir(path), rollupConfigPath)
+		}
+
+		data, err := os.ReadFile(rollupConfigPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read rollup config: %w", err)
+		}
+
+		var rollupCfg rollup.Config
+		if err := json.Unmarshal(data, &rollupCfg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal rollup config %s: %w", rollupConfigPath, err)
+		}
+
+		cfg.applyRollupConfig(&rollupCfg)
+	}
+
+	return &cfg, cfg.Check()
+}
+
+// applyRollupConfig fills in any unspecified fields from the rollup config
+func (c *CustomChainConfig) applyRollupConfig(rollupCfg *rollup.Config) {
+	if c.ChainID == 0 && rollupCfg.L2ChainID != nil {
+		c.ChainID = rollupCfg.L2ChainID.Uint64()
+	}
+	if c.L1ChainID == 0 && rollupCfg.L1ChainID != nil {
+		c.L1ChainID = rollupCfg.L1ChainID.Uint64()
+	}
+	if c.BlockTime == 0 {
+		c.BlockTime = rollupCfg.BlockTime
+	}
+	if c.GenesisL2Time == 0 {
+		c.GenesisL2Time = rollupCfg.Genesis.L2Time
+	}
+	if c.Addresses.OptimismPortalProxy == (registry.Address{}) {
+		c.Addresses.OptimismPortalProxy = registry.Address(rollupCfg.DepositContractAddress)
+	}
+	if c.Addresses.SystemConfigProxy == (registry.Address{}) {
+		c.Addresses.SystemConfigProxy = registry.Address(rollupCfg.L1SystemConfigAddress)
+	}
+}
+
+func (c *CustomChainConfig) Check() error {
+	if c.Name == "" {
+		return fmt.Errorf("custom chain is missing a name")
+	}
+	if c.RPCUrl == "" {
+		return fmt.Errorf("custom chain %s is missing an rpc url", c.Name)
+	}
+
+	// Required to derive the fork height from L1 and to mine blocks at the chain's own pace
+	if c.BlockTime == 0 {
+		return fmt.Errorf("custom chain %s must specify either the blockTime or a rollupConfig", c.Name)
+	}
+	if c.GenesisL2Time == 0 {
+		return fmt.Errorf("custom chain %s must specify either the genesisL2Time or a rollupConfig", c.Name)
+	}
+
+	// The portal is required to relay deposits. Otherwise we need the SystemConfig to resolve it
+	if c.Addresses.OptimismPortalProxy == (registry.Address{}) && c.Addresses.SystemConfigProxy == (registry.Address{}) {
+		return fmt.Errorf("custom chain %s must specify either the OptimismPortalProxy or SystemConfigProxy address", c.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	registry "github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCustomChainConfig(t *testing.T) {
+	dir := t.TempDir()
+
+	rollupConfig := `{
+		"genesis": {"l2_time": 1700000000},
+		"block_time": 1,
+		"l1_chain_id": 11155111,
+		"l2_chain_id": 424242,
+		"deposit_contract_address": "0x0000000000000000000000000000000000000001",
+		"l1_system_config_address": "0x0000000000000000000000000000000000000002"
+	}`
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "rollup.json"), []byte(rollupConfig), 0644))
+
+	chainConfig := `{
+		"name": "devnet",
+		"rpcUrl": "http://127.0.0.1:9545",
+		"rollupConfig": "rollup.json",
+		"addresses": {"L1StandardBridgeProxy": "0x0000000000000000000000000000000000000003"}
+	}`
+	path := filepath.Join(dir, "devnet.json")
+	require.NoError(t, os.WriteFile(path, []byte(chainConfig), 0644))
+
+	cfg, err := LoadCustomChainConfig(path)
+	require.NoError(t, err)
+
+	require.Equal(t, "devnet", cfg.Name)
+	require.Equal(t, uint64(424242), cfg.ChainID)
+	require.Equal(t, uint64(11155111), cfg.L1ChainID)
+	require.Equal(t, uint64(1), cfg.BlockTime)
+	require.Equal(t, uint64(1700000000), cfg.GenesisL2Time)
+	require.Equal(t, registry.Address(common.HexToAddress("0x1")), cfg.Addresses.OptimismPortalProxy)
+	require.Equal(t, registry.Address(common.HexToAddress("0x2")), cfg.Addresses.SystemConfigProxy)
+	require.Equal(t, registry.Address(common.HexToAddress("0x3")), cfg.Addresses.L1StandardBridgeProxy)
+}
+
+func TestCheckCustomChainConfig(t *testing.T) {
+	require.Error(t, (&CustomChainConfig{RPCUrl: "http://127.0.0.1:9545", Addresses: &registry.AddressList{}}).Check())
+	require.Error(t, (&CustomChainConfig{Name: "devnet", Addresses: &registry.AddressList{}}).Check())
+
+	// requires the block time & genesis time without a rollup config
+	require.Error(t, (&CustomChainConfig{Name: "devnet", RPCUrl: "http://127.0.0.1:9545", GenesisL2Time: 1700000000, Addresses: &registry.AddressList{OptimismPortalProxy: registry.Address{1}}}).Check())
+	require.Error(t, (&CustomChainConfig{Name: "devnet", RPCUrl: "http://127.0.0.1:9545", BlockTime: 2, Addresses: &registry.AddressList{OptimismPortalProxy: registry.Address{1}}}).Check())
+
+	// requires the portal or system config
+	require.Error(t, (&CustomChainConfig{Name: "devnet", RPCUrl: "http://127.0.0.1:9545", BlockTime: 2, GenesisL2Time: 1700000000, Addresses: &registry.AddressList{}}).Check())
+
+	require.NoError(t, (&CustomChainConfig{Name: "devnet", RPCUrl: "http://127.0.0.1:9545", BlockTime: 2, GenesisL2Time: 1700000000, Addresses: &registry.AddressList{OptimismPortalProxy: registry.Address{1}}}).Check())
+}
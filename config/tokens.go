@@ -0,0 +1,70 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultTokenDecimals are the decimals of a token when not specified
+	DefaultTokenDecimals = 18
+)
+
+// Token is an ERC20 deployed on startup, at the same address on every L2 for a SuperchainERC20
+type Token struct {
+	Name     string `json:"name"`
+	Symbol   string `json:"symbol"`
+	Decimals uint8  `json:"decimals"`
+
+	// Minted on every L2 once deployed, amounts in whole tokens
+	Balances []FundedAccount `json:"balances,omitempty"`
+}
+
+// ParseToken parses `<name>:<symbol>[:<decimals>]`
+func ParseToken(value string) (Token, error) {
+	parts := strings.Split(value, ":")
+	if len(parts) < 2 || len(parts) > 3 || parts[0] == "" || parts[1] == "" {
+		return Token{}, fmt.Errorf("expected <name>:<symbol>[:<decimals>], got `%s`", value)
+	}
+
+	token := Token{Name: parts[0], Symbol: parts[1], Decimals: DefaultTokenDecimals}
+	if len(parts) == 3 {
+		decimals, err := strconv.ParseUint(parts[2], 10, 8)
+		if err != nil {
+			return Token{}, fmt.Errorf("invalid decimals `%s`", parts[2])
+		}
+		token.Decimals = uint8(decimals)
+	}
+	return token, nil
+}
+
+// ParseTokenBalance parses `<symbol>:<address>=<amount>`, where the amount is in whole tokens
+func ParseTokenBalance(value string) (string, FundedAccount, error) {
+	symbol, balance, found := strings.Cut(value, ":")
+	if !found || symbol == "" {
+		return "", FundedAccount{}, fmt.Errorf("expected <symbol>:<address>=<amount>, got `%s`", value)
+	}
+
+	chain, account, err := ParseFundedAccount(balance)
+	if err != nil {
+		return "", FundedAccount{}, err
+	}
+	if chain != "" || account.Token != nil {
+		return "", FundedAccount{}, fmt.Errorf("expected <symbol>:<address>=<amount>, got `%s`", value)
+	}
+	return symbol, account, nil
+}
+
+// Check ensures the token can be deployed and its balances minted
+func (t Token) Check() error {
+	if t.Name == "" || t.Symbol == "" {
+		return fmt.Errorf("a name and symbol are required")
+	}
+	for _, balance := range t.Balances {
+		if _, err := balance.BaseUnits(t.Decimals); err != nil {
+			return fmt.Errorf("invalid balance of %s: %w", balance.Address, err)
+		}
+	}
+	return nil
+}
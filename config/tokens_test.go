@@ -0,0 +1,44 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseToken(t *testing.T) {
+	token, err := ParseToken("My Token:MTK")
+	require.NoError(t, err)
+	require.Equal(t, Token{Name: "My Token", Symbol: "MTK", Decimals: 18}, token)
+
+	token, err = ParseToken("USD Coin:USDC:6")
+	require.NoError(t, err)
+	require.Equal(t, uint8(6), token.Decimals)
+
+	for _, value := range []string{"MTK", ":MTK", "My Token:", "My Token:MTK:256", "My Token:MTK:6:1"} {
+		_, err := ParseToken(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestParseTokenBalance(t *testing.T) {
+	symbol, balance, err := ParseTokenBalance("MTK:0x0000000000000000000000000000000000001234=1.5")
+	require.NoError(t, err)
+	require.Equal(t, "MTK", symbol)
+	require.Equal(t, FundedAccount{Address: common.HexToAddress("0x1234"), Amount: "1.5"}, balance)
+
+	for _, value := range []string{"0x0000000000000000000000000000000000001234=1", "MTK:0x1234", "MTK:0x0000000000000000000000000000000000001234=1@0x0000000000000000000000000000000000005678"} {
+		_, _, err := ParseTokenBalance(value)
+		require.Error(t, err, value)
+	}
+}
+
+func TestTokenCheck(t *testing.T) {
+	token := Token{Name: "USD Coin", Symbol: "USDC", Decimals: 6}
+	token.Balances = []FundedAccount{{Address: common.HexToAddress("0x1234"), Amount: "1.000001"}}
+	require.NoError(t, token.Check())
+
+	token.Balances = []FundedAccount{{Address: common.HexToAddress("0x1234"), Amount: "1.0000001"}}
+	require.Error(t, token.Check())
+}
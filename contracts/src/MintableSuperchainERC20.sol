@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: MIT
+pragma solidity 0.8.25;
+
+import {SuperchainERC20} from "@contracts-bedrock/L2/SuperchainERC20.sol";
+
+/// @notice Thrown when attempting to mint or burn tokens and the account is the zero address.
+error ZeroAddress();
+
+/// @title MintableSuperchainERC20
+/// @notice SuperchainERC20 token with a configurable name, symbol & decimals, deployed by supersim at the same
+/// address on every L2. The mint/burn functionality is intentionally open to ANYONE to make it easier to test
+/// with. For production use, this functionality should be restricted.
+contract MintableSuperchainERC20 is SuperchainERC20 {
+    /// @notice Emitted whenever tokens are minted for an account.
+    /// @param account Address of the account tokens are being minted for.
+    /// @param amount  Amount of tokens minted.
+    event Mint(address indexed account, uint256 amount);
+
+    /// @notice Emitted whenever tokens are burned from an account.
+    /// @param account Address of the account tokens are being burned from.
+    /// @param amount  Amount of tokens burned.
+    event Burn(address indexed account, uint256 amount);
+
+    string private _name;
+    string private _symbol;
+    uint8 private immutable _decimals;
+
+    /// @param name_     Name of the token.
+    /// @param symbol_   Symbol of the token.
+    /// @param decimals_ Decimals of the token.
+    constructor(string memory name_, string memory symbol_, uint8 decimals_) {
+        _name = name_;
+        _symbol = symbol_;
+        _decimals = decimals_;
+    }
+
+    /// @notice Allows ANYONE to mint tokens. For production use, this should be restricted.
+    /// @param _to     Address to mint tokens to.
+    /// @param _amount Amount of tokens to mint.
+    function mint(address _to, uint256 _amount) external {
+        if (_to == address(0)) revert ZeroAddress();
+
+        _mint(_to, _amount);
+
+        emit Mint(_to, _amount);
+    }
+
+    /// @notice Allows ANYONE to burn tokens. For production use, this should be restricted.
+    /// @param _from   Address to burn tokens from.
+    /// @param _amount Amount of tokens to burn.
+    function burn(address _from, uint256 _amount) external {
+        if (_from == address(0)) revert ZeroAddress();
+
+        _burn(_from, _amount);
+
+        emit Burn(_from, _amount);
+    }
+
+    function name() public view override returns (string memory) {
+        return _name;
+    }
+
+    function symbol() public view override returns (string memory) {
+        return _symbol;
+    }
+
+    function decimals() public view override returns (uint8) {
+        return _decimals;
+    }
+}
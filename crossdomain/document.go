@@ -0,0 +1,56 @@
+package crossdomain
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// MessageDocument is the JSON representation of an indexed message, including the arguments
+// needed to replay it through `relayMessage` on the destination messenger
+type MessageDocument struct {
+	MessageHash common.Hash    `json:"messageHash"`
+	Direction   string         `json:"direction"`
+	Source      uint64         `json:"source"`
+	Destination uint64         `json:"destination"`
+	Nonce       *hexutil.Big   `json:"nonce"`
+	Sender      common.Address `json:"sender"`
+	Target      common.Address `json:"target"`
+	Value       *hexutil.Big   `json:"value"`
+	GasLimit    *hexutil.Big   `json:"gasLimit"`
+	Message     hexutil.Bytes  `json:"message"`
+
+	Status         string        `json:"status"`
+	SentTxHash     common.Hash   `json:"sentTxHash"`
+	RelayedTxHash  *common.Hash  `json:"relayedTxHash,omitempty"`
+	FailedTxHashes []common.Hash `json:"failedTxHashes,omitempty"`
+}
+
+func (e *MessageStoreEntry) Document() (*MessageDocument, error) {
+	msgHash, err := e.message.Hash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate message hash: %w", err)
+	}
+
+	doc := &MessageDocument{
+		MessageHash: msgHash,
+		Direction:   e.message.Direction.String(),
+		Source:      e.message.Source,
+		Destination: e.message.Destination,
+		Nonce:       (*hexutil.Big)(e.message.Nonce),
+		Sender:      e.message.Sender,
+		Target:      e.message.Target,
+		Value:       (*hexutil.Big)(e.message.Value),
+		GasLimit:    (*hexutil.Big)(e.message.GasLimit),
+		Message:     e.message.Message,
+
+		Status:         e.lifecycle.Status().String(),
+		SentTxHash:     e.lifecycle.SentTxHash,
+		FailedTxHashes: e.lifecycle.FailedTxHashes,
+	}
+	if e.lifecycle.RelayedTxHash != (common.Hash{}) {
+		doc.RelayedTxHash = &e.lifecycle.RelayedTxHash
+	}
+	return doc, nil
+}
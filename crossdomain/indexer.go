@@ -0,0 +1,187 @@
+package crossdomain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/tasks"
+
+	"github.com/ethereum-optimism/supersim/logfollower"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// IndexedChain is a chain whose messenger logs are indexed from the starting block, such that messages sent
+// before the indexer starts are indexed
+type IndexedChain struct {
+	ChainID    uint64
+	Client     *ethclient.Client
+	StartBlock uint64
+}
+
+// MessageIndexer tracks the messages sent between the L1 and the L2s through the
+// L1CrossDomainMessenger and L2CrossDomainMessenger of every L2
+type MessageIndexer struct {
+	log          log.Logger
+	storeManager *MessageStoreManager
+	tasks        tasks.Group
+	tasksCtx     context.Context
+	tasksCancel  context.CancelFunc
+
+	// Followers of the L1 & L2s by chain id
+	followers map[uint64]*logfollower.Follower
+}
+
+func NewMessageIndexer(log log.Logger) *MessageIndexer {
+	tasksCtx, tasksCancel := context.WithCancel(context.Background())
+
+	return &MessageIndexer{
+		log:          log,
+		storeManager: NewMessageStoreManager(),
+		tasks: tasks.Group{
+			HandleCrit: func(err error) {
+				log.Error("unhandled indexer error", "err", err)
+			},
+		},
+		tasksCtx:    tasksCtx,
+		tasksCancel: tasksCancel,
+	}
+}
+
+// Start indexes the L1CrossDomainMessenger of every L2, keyed by address, on the L1 and the
+// L2CrossDomainMessenger predeploy on every L2
+func (i *MessageIndexer) Start(ctx context.Context, l1 IndexedChain, l1Messengers map[common.Address]uint64, l2s []IndexedChain) error {
+	messengers := make([]common.Address, 0, len(l1Messengers))
+	for addr := range l1Messengers {
+		messengers = append(messengers, addr)
+	}
+
+	l1Follower := logfollower.New(i.log.New("chain.id", l1.ChainID), l1.Client, ethereum.FilterQuery{Addresses: messengers}, l1.StartBlock, func(_ context.Context, log *types.Log) error {
+		l2ChainID, ok := l1Messengers[log.Address]
+		if !ok {
+			return fmt.Errorf("unexpected L1CrossDomainMessenger %s", log.Address)
+		}
+		return i.processEventLog(l1.Client, "L1CrossDomainMessenger", L1ToL2, l1.ChainID, l2ChainID, log)
+	})
+	i.followers = map[uint64]*logfollower.Follower{l1.ChainID: l1Follower}
+	i.tasks.Go(func() error { return l1Follower.Run(i.tasksCtx) })
+
+	for _, l2 := range l2s {
+		l2Query := ethereum.FilterQuery{Addresses: []common.Address{predeploys.L2CrossDomainMessengerAddr}}
+		l2Follower := logfollower.New(i.log.New("chain.id", l2.ChainID), l2.Client, l2Query, l2.StartBlock, func(_ context.Context, log *types.Log) error {
+			return i.processEventLog(l2.Client, "L2CrossDomainMessenger", L2ToL1, l2.ChainID, l1.ChainID, log)
+		})
+		i.followers[l2.ChainID] = l2Follower
+		i.tasks.Go(func() error { return l2Follower.Run(i.tasksCtx) })
+	}
+	return nil
+}
+
+// Rewind indexes the messages of the chain from the block onwards, once the chain is reset
+func (i *MessageIndexer) Rewind(chainID, block uint64) error {
+	follower, ok := i.followers[chainID]
+	if !ok {
+		return fmt.Errorf("chain %d is not indexed", chainID)
+	}
+	follower.Rewind(block)
+	return nil
+}
+
+func (i *MessageIndexer) Stop(ctx context.Context) error {
+	i.tasksCancel()
+	return nil
+}
+
+func (i *MessageIndexer) Get(key MessageKey) (*MessageStoreEntry, error) {
+	return i.storeManager.Get(key)
+}
+
+// GetMessage returns the indexed state of the message, nil if the message has not been indexed
+func (i *MessageIndexer) GetMessage(ctx context.Context, key MessageKey) (*MessageDocument, error) {
+	entry, err := i.storeManager.Get(key)
+	if errors.Is(err, ErrMessageNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entry.Document()
+}
+
+// ListMessages returns every indexed message, ordered by source chain, destination chain and nonce
+func (i *MessageIndexer) ListMessages(ctx context.Context) ([]*MessageDocument, error) {
+	entries := i.storeManager.Entries()
+	docs := make([]*MessageDocument, 0, len(entries))
+	for _, entry := range entries {
+		doc, err := entry.Document()
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
+// processEventLog handles a log of the messenger, sending in the direction from `chainID` to `remoteChainID`
+func (i *MessageIndexer) processEventLog(backend ethereum.TransactionReader, messenger string, direction Direction, chainID, remoteChainID uint64, log *types.Log) error {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+
+	switch log.Topics[0] {
+	case sentMessageEventID:
+		receipt, err := backend.TransactionReceipt(i.tasksCtx, log.TxHash)
+		if err != nil {
+			return fmt.Errorf("%w: failed to fetch receipt of %s: %w", logfollower.ErrRetry, log.TxHash, err)
+		}
+
+		// SentMessageExtension1 is emitted right after SentMessage
+		var extensionLog *types.Log
+		for _, receiptLog := range receipt.Logs {
+			if receiptLog.Index == log.Index+1 {
+				extensionLog = receiptLog
+				break
+			}
+		}
+		if extensionLog == nil {
+			return fmt.Errorf("missing SentMessageExtension1 event in %s", log.TxHash)
+		}
+
+		entry, err := i.storeManager.HandleSentEvent(direction, chainID, remoteChainID, log, extensionLog)
+		if err != nil {
+			return fmt.Errorf("failed to handle SentMessage event: %w", err)
+		}
+		i.logMessageEvent(messenger, "SentMessage", entry, log)
+	case relayedMessageEventID:
+		entry, err := i.storeManager.HandleRelayedEvent(remoteChainID, chainID, log)
+		if err != nil {
+			return fmt.Errorf("failed to handle RelayedMessage event: %w", err)
+		}
+		i.logMessageEvent(messenger, "RelayedMessage", entry, log)
+	case failedRelayedMessageEventID:
+		entry, err := i.storeManager.HandleFailedRelayedEvent(remoteChainID, chainID, log)
+		if err != nil {
+			return fmt.Errorf("failed to handle FailedRelayedMessage event: %w", err)
+		}
+		i.logMessageEvent(messenger, "FailedRelayedMessage", entry, log)
+	}
+
+	return nil
+}
+
+func (i *MessageIndexer) logMessageEvent(messenger, eventName string, entry *MessageStoreEntry, log *types.Log) {
+	// relays observed before the message are logged once it is indexed
+	if entry == nil {
+		i.log.Debug(fmt.Sprintf("%s#%s", messenger, eventName), "msgHash", log.Topics[1], "txHash", log.TxHash.String())
+		return
+	}
+
+	msg := entry.Message()
+	i.log.Info(fmt.Sprintf("%s#%s", messenger, eventName), "sourceChainID", msg.Source, "destinationChainID", msg.Destination, "nonce", msg.Nonce, "sender", msg.Sender, "target", msg.Target, "value", msg.Value, "status", entry.Lifecycle().Status(), "txHash", log.TxHash.String())
+}
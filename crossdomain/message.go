@@ -0,0 +1,108 @@
+package crossdomain
+
+import (
+	"fmt"
+	"math/big"
+
+	opcrossdomain "github.com/ethereum-optimism/optimism/op-chain-ops/crossdomain"
+	opbindings "github.com/ethereum-optimism/optimism/op-e2e/bindings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	crossDomainMessengerABI, _ = opbindings.CrossDomainMessengerMetaData.GetAbi()
+
+	sentMessageEventID           = crossDomainMessengerABI.Events["SentMessage"].ID
+	sentMessageExtension1EventID = crossDomainMessengerABI.Events["SentMessageExtension1"].ID
+	relayedMessageEventID        = crossDomainMessengerABI.Events["RelayedMessage"].ID
+	failedRelayedMessageEventID  = crossDomainMessengerABI.Events["FailedRelayedMessage"].ID
+)
+
+// Direction of a message between the L1 and an L2
+type Direction uint
+
+const (
+	L1ToL2 Direction = iota
+	L2ToL1
+)
+
+func (d Direction) String() string {
+	switch d {
+	case L1ToL2:
+		return "l1tol2"
+	case L2ToL1:
+		return "l2tol1"
+	default:
+		return "unknown"
+	}
+}
+
+// Message is a message sent through the L1CrossDomainMessenger or the L2CrossDomainMessenger
+type Message struct {
+	Direction   Direction
+	Source      uint64
+	Destination uint64
+
+	// Nonce is the versioned nonce of the messenger
+	Nonce    *big.Int
+	Sender   common.Address
+	Target   common.Address
+	Value    *big.Int
+	GasLimit *big.Int
+	Message  []byte
+}
+
+// Hash returns the hash of the message, as tracked by the `successfulMessages` & `failedMessages` of
+// the destination messenger
+func (m *Message) Hash() (common.Hash, error) {
+	return opcrossdomain.HashCrossDomainMessageV1(m.Nonce, m.Sender, m.Target, m.Value, m.GasLimit, m.Message)
+}
+
+// NewMessageFromSentMessageEventData decodes the SentMessage event and the SentMessageExtension1 event emitted right
+// after it, which carries the value of the message
+func NewMessageFromSentMessageEventData(direction Direction, source, destination uint64, sentLog, extensionLog *types.Log) (*Message, error) {
+	if len(sentLog.Topics) != 2 || sentLog.Topics[0] != sentMessageEventID {
+		return nil, fmt.Errorf("log is not a SentMessage event")
+	}
+	if len(extensionLog.Topics) != 2 || extensionLog.Topics[0] != sentMessageExtension1EventID {
+		return nil, fmt.Errorf("log is not a SentMessageExtension1 event")
+	}
+
+	event := new(opbindings.CrossDomainMessengerSentMessage)
+	if err := crossDomainMessengerABI.UnpackIntoInterface(event, "SentMessage", sentLog.Data); err != nil {
+		return nil, fmt.Errorf("failed to unpack SentMessage event: %w", err)
+	}
+	if err := abi.ParseTopics(event, indexedArguments("SentMessage"), sentLog.Topics[1:]); err != nil {
+		return nil, fmt.Errorf("failed to parse SentMessage topics: %w", err)
+	}
+
+	extension := new(opbindings.CrossDomainMessengerSentMessageExtension1)
+	if err := crossDomainMessengerABI.UnpackIntoInterface(extension, "SentMessageExtension1", extensionLog.Data); err != nil {
+		return nil, fmt.Errorf("failed to unpack SentMessageExtension1 event: %w", err)
+	}
+
+	return &Message{
+		Direction:   direction,
+		Source:      source,
+		Destination: destination,
+		Nonce:       event.MessageNonce,
+		Sender:      event.Sender,
+		Target:      event.Target,
+		Value:       extension.Value,
+		GasLimit:    event.GasLimit,
+		Message:     event.Message,
+	}, nil
+}
+
+func indexedArguments(eventName string) abi.Arguments {
+	var indexed abi.Arguments
+	for _, arg := range crossDomainMessengerABI.Events[eventName].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	return indexed
+}
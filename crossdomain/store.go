@@ -0,0 +1,281 @@
+package crossdomain
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type MessageState uint
+
+const (
+	Sent MessageState = iota
+	Relayed
+	FailedRelay
+	Replayed
+)
+
+var ErrMessageNotFound = errors.New("message not found")
+
+func (s MessageState) String() string {
+	switch s {
+	case Sent:
+		return "sent"
+	case Relayed:
+		return "relayed"
+	case FailedRelay:
+		return "failed"
+	case Replayed:
+		return "replayed"
+	default:
+		return "unknown"
+	}
+}
+
+// MessageLifecycle tracks the transactions of a message. A failed message remains replayable
+// on the destination messenger until it is successfully relayed
+type MessageLifecycle struct {
+	SentTxHash     common.Hash
+	FailedTxHashes []common.Hash
+	RelayedTxHash  common.Hash
+}
+
+func (s *MessageLifecycle) WithFailedTxHash(hash common.Hash) *MessageLifecycle {
+	return &MessageLifecycle{
+		SentTxHash:     s.SentTxHash,
+		FailedTxHashes: append(append([]common.Hash{}, s.FailedTxHashes...), hash),
+		RelayedTxHash:  s.RelayedTxHash,
+	}
+}
+
+func (s *MessageLifecycle) WithRelayedTxHash(hash common.Hash) *MessageLifecycle {
+	return &MessageLifecycle{
+		SentTxHash:     s.SentTxHash,
+		FailedTxHashes: append([]common.Hash{}, s.FailedTxHashes...),
+		RelayedTxHash:  hash,
+	}
+}
+
+func (s *MessageLifecycle) Status() MessageState {
+	if s.RelayedTxHash != (common.Hash{}) {
+		if len(s.FailedTxHashes) > 0 {
+			return Replayed
+		}
+		return Relayed
+	}
+	if len(s.FailedTxHashes) > 0 {
+		return FailedRelay
+	}
+	return Sent
+}
+
+// MessageKey identifies a message. The hash alone is not unique as the same message can be
+// sent from several L2s to the L1
+type MessageKey struct {
+	Source      uint64
+	Destination uint64
+	Hash        common.Hash
+}
+
+type MessageStoreEntry struct {
+	message   *Message
+	lifecycle *MessageLifecycle
+}
+
+func (e *MessageStoreEntry) Message() *Message {
+	return e.message
+}
+
+func (e *MessageStoreEntry) Lifecycle() *MessageLifecycle {
+	return e.lifecycle
+}
+
+type MessageStore struct {
+	entryByKey map[MessageKey]*MessageStoreEntry
+
+	// lifecycles of relays observed on the destination before the message was indexed on the source
+	pendingByKey map[MessageKey]*MessageLifecycle
+
+	mu sync.RWMutex
+}
+
+func NewMessageStore() *MessageStore {
+	return &MessageStore{
+		entryByKey:   make(map[MessageKey]*MessageStoreEntry),
+		pendingByKey: make(map[MessageKey]*MessageLifecycle),
+	}
+}
+
+// Set stores the entry, merging any relay observed before the message was sent
+func (s *MessageStore) Set(key MessageKey, entry *MessageStoreEntry) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if pending, ok := s.pendingByKey[key]; ok {
+		entry = &MessageStoreEntry{
+			message: entry.message,
+			lifecycle: &MessageLifecycle{
+				SentTxHash:     entry.lifecycle.SentTxHash,
+				FailedTxHashes: pending.FailedTxHashes,
+				RelayedTxHash:  pending.RelayedTxHash,
+			},
+		}
+		delete(s.pendingByKey, key)
+	}
+
+	s.entryByKey[key] = entry
+	return nil
+}
+
+func (s *MessageStore) Get(key MessageKey) (*MessageStoreEntry, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entry, exists := s.entryByKey[key]
+	if !exists {
+		return nil, ErrMessageNotFound
+	}
+	return entry, nil
+}
+
+// Entries returns every stored entry, ordered by source chain, destination chain and nonce
+func (s *MessageStore) Entries() []*MessageStoreEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]*MessageStoreEntry, 0, len(s.entryByKey))
+	for _, entry := range s.entryByKey {
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].message.Source != entries[j].message.Source {
+			return entries[i].message.Source < entries[j].message.Source
+		}
+		if entries[i].message.Destination != entries[j].message.Destination {
+			return entries[i].message.Destination < entries[j].message.Destination
+		}
+		return entries[i].message.Nonce.Cmp(entries[j].message.Nonce) < 0
+	})
+	return entries
+}
+
+type UpdaterFunc func(lifecycle *MessageLifecycle) (*MessageLifecycle, error)
+
+// UpdateLifecycle updates the lifecycle of the message. The entry is nil when the message has not
+// been indexed yet, in which case the update is applied once it is
+func (s *MessageStore) UpdateLifecycle(key MessageKey, updater UpdaterFunc) (*MessageStoreEntry, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entry, exists := s.entryByKey[key]
+	if !exists {
+		pending, ok := s.pendingByKey[key]
+		if !ok {
+			pending = &MessageLifecycle{}
+		}
+
+		newLifecycle, err := updater(pending)
+		if err != nil {
+			return nil, fmt.Errorf("failed to update lifecycle: %w", err)
+		}
+		s.pendingByKey[key] = newLifecycle
+		return nil, nil
+	}
+
+	newLifecycle, err := updater(entry.lifecycle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update lifecycle: %w", err)
+	}
+
+	newEntry := &MessageStoreEntry{message: entry.message, lifecycle: newLifecycle}
+	s.entryByKey[key] = newEntry
+	return newEntry, nil
+}
+
+type MessageStoreManager struct {
+	store *MessageStore
+}
+
+func NewMessageStoreManager() *MessageStoreManager {
+	return &MessageStoreManager{
+		store: NewMessageStore(),
+	}
+}
+
+func (m *MessageStoreManager) Get(key MessageKey) (*MessageStoreEntry, error) {
+	return m.store.Get(key)
+}
+
+func (m *MessageStoreManager) Entries() []*MessageStoreEntry {
+	return m.store.Entries()
+}
+
+func (m *MessageStoreManager) HandleSentEvent(direction Direction, source, destination uint64, sentLog, extensionLog *types.Log) (*MessageStoreEntry, error) {
+	msg, err := NewMessageFromSentMessageEventData(direction, source, destination, sentLog, extensionLog)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Message: %w", err)
+	}
+
+	msgHash, err := msg.Hash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate message hash: %w", err)
+	}
+
+	entry := &MessageStoreEntry{
+		message:   msg,
+		lifecycle: &MessageLifecycle{SentTxHash: sentLog.TxHash},
+	}
+
+	key := MessageKey{Source: source, Destination: destination, Hash: msgHash}
+	if err := m.store.Set(key, entry); err != nil {
+		return nil, fmt.Errorf("failed to store message: %w", err)
+	}
+
+	// the stored entry includes any relay observed beforehand
+	return m.store.Get(key)
+}
+
+func (m *MessageStoreManager) HandleRelayedEvent(source, destination uint64, log *types.Log) (*MessageStoreEntry, error) {
+	if log.Topics[0] != relayedMessageEventID {
+		return nil, fmt.Errorf("unexpected event type")
+	}
+
+	key := MessageKey{Source: source, Destination: destination, Hash: log.Topics[1]}
+	updatedEntry, err := m.store.UpdateLifecycle(key, func(lifecycle *MessageLifecycle) (*MessageLifecycle, error) {
+		if lifecycle.RelayedTxHash != (common.Hash{}) {
+			return nil, fmt.Errorf("message already relayed")
+		}
+
+		return lifecycle.WithRelayedTxHash(log.TxHash), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update lifecycle for relayed event: %w", err)
+	}
+
+	return updatedEntry, nil
+}
+
+func (m *MessageStoreManager) HandleFailedRelayedEvent(source, destination uint64, log *types.Log) (*MessageStoreEntry, error) {
+	if log.Topics[0] != failedRelayedMessageEventID {
+		return nil, fmt.Errorf("unexpected event type")
+	}
+
+	key := MessageKey{Source: source, Destination: destination, Hash: log.Topics[1]}
+	updatedEntry, err := m.store.UpdateLifecycle(key, func(lifecycle *MessageLifecycle) (*MessageLifecycle, error) {
+		if lifecycle.RelayedTxHash != (common.Hash{}) {
+			return nil, fmt.Errorf("message already relayed")
+		}
+
+		return lifecycle.WithFailedTxHash(log.TxHash), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update lifecycle for failed relayed event: %w", err)
+	}
+
+	return updatedEntry, nil
+}
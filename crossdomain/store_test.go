@@ -0,0 +1,134 @@
+package crossdomain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageLifecycle_Status(t *testing.T) {
+	lifecycle := &MessageLifecycle{SentTxHash: common.HexToHash("0x1")}
+	assert.Equal(t, Sent, lifecycle.Status(), "expected status to be Sent")
+
+	relayed := lifecycle.WithRelayedTxHash(common.HexToHash("0x2"))
+	assert.Equal(t, Relayed, relayed.Status(), "expected status to be Relayed")
+
+	lifecycle = lifecycle.WithFailedTxHash(common.HexToHash("0x3"))
+	assert.Equal(t, FailedRelay, lifecycle.Status(), "expected status to be FailedRelay")
+
+	lifecycle = lifecycle.WithRelayedTxHash(common.HexToHash("0x4"))
+	assert.Equal(t, Replayed, lifecycle.Status(), "expected status to be Replayed")
+}
+
+func TestMessageStore_UpdateLifecycle(t *testing.T) {
+	store := NewMessageStore()
+	msg := &Message{
+		Direction:   L1ToL2,
+		Source:      900,
+		Destination: 901,
+		Nonce:       big.NewInt(1),
+		Sender:      common.HexToAddress("0x1"),
+		Target:      common.HexToAddress("0x2"),
+		Value:       big.NewInt(0),
+		GasLimit:    big.NewInt(100_000),
+		Message:     []byte("hello world"),
+	}
+
+	msgHash, err := msg.Hash()
+	require.NoError(t, err)
+	key := MessageKey{Source: msg.Source, Destination: msg.Destination, Hash: msgHash}
+
+	require.NoError(t, store.Set(key, &MessageStoreEntry{message: msg, lifecycle: &MessageLifecycle{SentTxHash: common.HexToHash("0x1")}}))
+
+	entry, err := store.UpdateLifecycle(key, func(lifecycle *MessageLifecycle) (*MessageLifecycle, error) {
+		return lifecycle.WithFailedTxHash(common.HexToHash("0x2")), nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, FailedRelay, entry.Lifecycle().Status())
+
+	// the same message sent from another chain is tracked separately
+	_, err = store.Get(MessageKey{Source: 902, Destination: msg.Destination, Hash: msgHash})
+	assert.ErrorIs(t, err, ErrMessageNotFound)
+}
+
+func TestMessageStore_RelayedBeforeSent(t *testing.T) {
+	store := NewMessageStore()
+	msg := &Message{
+		Direction:   L2ToL1,
+		Source:      901,
+		Destination: 900,
+		Nonce:       big.NewInt(1),
+		Sender:      common.HexToAddress("0x1"),
+		Target:      common.HexToAddress("0x2"),
+		Value:       big.NewInt(1),
+		GasLimit:    big.NewInt(100_000),
+	}
+
+	msgHash, err := msg.Hash()
+	require.NoError(t, err)
+	key := MessageKey{Source: msg.Source, Destination: msg.Destination, Hash: msgHash}
+
+	entry, err := store.UpdateLifecycle(key, func(lifecycle *MessageLifecycle) (*MessageLifecycle, error) {
+		return lifecycle.WithRelayedTxHash(common.HexToHash("0x3")), nil
+	})
+	require.NoError(t, err)
+	assert.Nil(t, entry, "expected no entry before the message is indexed")
+
+	require.NoError(t, store.Set(key, &MessageStoreEntry{message: msg, lifecycle: &MessageLifecycle{SentTxHash: common.HexToHash("0x1")}}))
+
+	entry, err = store.Get(key)
+	require.NoError(t, err)
+	assert.Equal(t, Relayed, entry.Lifecycle().Status())
+	assert.Equal(t, common.HexToHash("0x1"), entry.Lifecycle().SentTxHash)
+	assert.Equal(t, common.HexToHash("0x3"), entry.Lifecycle().RelayedTxHash)
+}
+
+func TestMessageStoreManager_HandleEvents(t *testing.T) {
+	manager := NewMessageStoreManager()
+
+	target, sender := common.HexToAddress("0x2"), common.HexToAddress("0x1")
+	sentData, err := crossDomainMessengerABI.Events["SentMessage"].Inputs.NonIndexed().Pack(sender, []byte("hello"), big.NewInt(5), big.NewInt(200_000))
+	require.NoError(t, err)
+	extensionData, err := crossDomainMessengerABI.Events["SentMessageExtension1"].Inputs.NonIndexed().Pack(big.NewInt(10))
+	require.NoError(t, err)
+
+	sentLog := &types.Log{Topics: []common.Hash{sentMessageEventID, common.BytesToHash(target.Bytes())}, Data: sentData, TxHash: common.HexToHash("0xa"), Index: 0}
+	extensionLog := &types.Log{Topics: []common.Hash{sentMessageExtension1EventID, common.BytesToHash(sender.Bytes())}, Data: extensionData, TxHash: common.HexToHash("0xa"), Index: 1}
+
+	entry, err := manager.HandleSentEvent(L1ToL2, 900, 901, sentLog, extensionLog)
+	require.NoError(t, err)
+
+	msg := entry.Message()
+	assert.Equal(t, target, msg.Target)
+	assert.Equal(t, sender, msg.Sender)
+	assert.Equal(t, big.NewInt(5), msg.Nonce)
+	assert.Equal(t, big.NewInt(10), msg.Value)
+	assert.Equal(t, big.NewInt(200_000), msg.GasLimit)
+	assert.Equal(t, []byte("hello"), msg.Message)
+
+	msgHash, err := msg.Hash()
+	require.NoError(t, err)
+
+	failedLog := &types.Log{Topics: []common.Hash{failedRelayedMessageEventID, msgHash}, TxHash: common.HexToHash("0xb")}
+	entry, err = manager.HandleFailedRelayedEvent(900, 901, failedLog)
+	require.NoError(t, err)
+	assert.Equal(t, FailedRelay, entry.Lifecycle().Status())
+
+	relayedLog := &types.Log{Topics: []common.Hash{relayedMessageEventID, msgHash}, TxHash: common.HexToHash("0xc")}
+	entry, err = manager.HandleRelayedEvent(900, 901, relayedLog)
+	require.NoError(t, err)
+	assert.Equal(t, Replayed, entry.Lifecycle().Status())
+
+	_, err = manager.HandleRelayedEvent(900, 901, relayedLog)
+	assert.Error(t, err, "expected an error when relaying twice")
+
+	doc, err := entry.Document()
+	require.NoError(t, err)
+	assert.Equal(t, "replayed", doc.Status)
+	assert.Equal(t, "l1tol2", doc.Direction)
+	assert.Equal(t, msgHash, doc.MessageHash)
+}
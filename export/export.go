@@ -0,0 +1,192 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	registry "github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/ethereum-optimism/supersim/orchestrator"
+)
+
+type Format string
+
+const (
+	// FoundryFormat is the `[rpc_endpoints]` table of a foundry.toml
+	FoundryFormat Format = "foundry"
+	// HardhatFormat is the `networks` entry of a hardhat config
+	HardhatFormat Format = "hardhat"
+	// DotenvFormat is a .env file of rpc urls, chain ids & contract addresses
+	DotenvFormat Format = "dotenv"
+)
+
+var Formats = []Format{FoundryFormat, HardhatFormat, DotenvFormat}
+
+func (f Format) IsValid() bool {
+	for _, format := range Formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// Write renders the configuration of a running instance in the specified format
+func Write(w io.Writer, doc *orchestrator.ConfigDocument, format Format) error {
+	switch format {
+	case FoundryFormat:
+		return writeFoundry(w, doc)
+	case HardhatFormat:
+		return writeHardhat(w, doc)
+	case DotenvFormat:
+		return writeDotenv(w, doc)
+	default:
+		return fmt.Errorf("unrecognized export format: %s", format)
+	}
+}
+
+func writeFoundry(w io.Writer, doc *orchestrator.ConfigDocument) error {
+	var b strings.Builder
+	fmt.Fprintln(&b, "[rpc_endpoints]")
+	fmt.Fprintf(&b, "l1 = %q\n", doc.L1.RPCUrl)
+	for _, l2 := range doc.L2s {
+		fmt.Fprintf(&b, "%s = %q\n", networkName(l2.Name), l2.RPCUrl)
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+type hardhatNetwork struct {
+	URL      string   `json:"url"`
+	ChainID  uint64   `json:"chainId"`
+	Accounts []string `json:"accounts"`
+}
+
+func writeHardhat(w io.Writer, doc *orchestrator.ConfigDocument) error {
+	accounts := make([]string, 0, len(doc.Accounts))
+	for _, account := range doc.Accounts {
+		accounts = append(accounts, account.PrivateKey)
+	}
+
+	networks := map[string]hardhatNetwork{
+		"l1": {URL: doc.L1.RPCUrl, ChainID: doc.L1.ChainID, Accounts: accounts},
+	}
+	for _, l2 := range doc.L2s {
+		networks[networkName(l2.Name)] = hardhatNetwork{URL: l2.RPCUrl, ChainID: l2.ChainID, Accounts: accounts}
+	}
+
+	// map keys are sorted when encoded
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(map[string]any{"networks": networks})
+}
+
+func writeDotenv(w io.Writer, doc *orchestrator.ConfigDocument) error {
+	var b strings.Builder
+	if doc.AdminRPC != "" {
+		fmt.Fprintf(&b, "SUPERSIM_ADMIN_RPC=%s\n", doc.AdminRPC)
+	}
+	if len(doc.Accounts) > 0 {
+		fmt.Fprintf(&b, "PRIVATE_KEY=%s\n", doc.Accounts[0].PrivateKey)
+	}
+
+	fmt.Fprintf(&b, "\nL1_RPC_URL=%s\n", doc.L1.RPCUrl)
+	fmt.Fprintf(&b, "L1_CHAIN_ID=%d\n", doc.L1.ChainID)
+	for _, token := range doc.L1Tokens {
+		fmt.Fprintf(&b, "L1_%s_TOKEN=%s\n", envName(token.Symbol), token.Address)
+	}
+
+	for _, l2 := range doc.L2s {
+		prefix := envName(l2.Name)
+		fmt.Fprintf(&b, "\n%s_RPC_URL=%s\n", prefix, l2.RPCUrl)
+		fmt.Fprintf(&b, "%s_CHAIN_ID=%d\n", prefix, l2.ChainID)
+
+		addresses, err := addressMap(l2.L1Addresses)
+		if err != nil {
+			return fmt.Errorf("failed to encode l1 addresses of %s: %w", l2.Name, err)
+		}
+		for _, name := range sortedKeys(addresses) {
+			fmt.Fprintf(&b, "%s_%s=%s\n", prefix, envName(name), addresses[name])
+		}
+		for _, token := range doc.L1Tokens {
+			if l2Token, ok := token.L2Token(l2.ChainID); ok {
+				fmt.Fprintf(&b, "%s_%s_TOKEN=%s\n", prefix, envName(token.Symbol), l2Token)
+			}
+		}
+	}
+
+	if doc.Interop != nil {
+		fmt.Fprintln(&b)
+		for _, name := range sortedKeys(doc.Interop.Predeploys) {
+			fmt.Fprintf(&b, "%s=%s\n", envName(name), doc.Interop.Predeploys[name])
+		}
+		for _, token := range doc.Interop.Tokens {
+			fmt.Fprintf(&b, "%s_TOKEN=%s\n", envName(token.Symbol), token.Address)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// addressMap returns the addresses of the list keyed by contract name. Zero addresses are omitted when encoded
+func addressMap(addresses *registry.AddressList) (map[string]string, error) {
+	if addresses == nil {
+		return nil, nil
+	}
+
+	encoded, err := json.Marshal(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	var decoded map[string]string
+	if err := json.Unmarshal(encoded, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
+
+// networkName is the lowercase chain name, i.e `OPChainA` -> `opchaina`
+func networkName(name string) string {
+	return strings.ToLower(strings.Join(strings.FieldsFunc(name, isSeparator), "_"))
+}
+
+// envName converts a camel case name to screaming snake case, i.e `L1StandardBridgeProxy` -> `L1_STANDARD_BRIDGE_PROXY`
+func envName(name string) string {
+	var b strings.Builder
+	runes := []rune(name)
+	for i, r := range runes {
+		if isSeparator(r) {
+			b.WriteRune('_')
+			continue
+		}
+
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				b.WriteRune('_')
+			}
+		}
+		b.WriteRune(unicode.ToUpper(r))
+	}
+	return b.String()
+}
+
+func isSeparator(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,91 @@
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	registry "github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/orchestrator"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfigDocument() *orchestrator.ConfigDocument {
+	return &orchestrator.ConfigDocument{
+		AdminRPC: "http://127.0.0.1:8420",
+		L1:       &orchestrator.ChainDocument{Name: "Local", ChainID: 900, RPCUrl: "http://127.0.0.1:8545"},
+		L2s: []*orchestrator.ChainDocument{
+			{
+				Name:    "OPChainA",
+				ChainID: 901,
+				RPCUrl:  "http://127.0.0.1:9545",
+				L1Addresses: &registry.AddressList{
+					OptimismPortalProxy:         registry.MustHexToAddress("0x0000000000000000000000000000000000000001"),
+					L1CrossDomainMessengerProxy: registry.MustHexToAddress("0x0000000000000000000000000000000000000002"),
+				},
+			},
+		},
+		Interop: &orchestrator.InteropDocument{
+			Predeploys: map[string]common.Address{"L2ToL2CrossDomainMessenger": common.HexToAddress("0x4200000000000000000000000000000000000023")},
+			Tokens:     []*orchestrator.SuperchainERC20Deployment{{Name: "My Token", Symbol: "MTK", Decimals: 18, Address: common.HexToAddress("0x0000000000000000000000000000000000000003")}},
+		},
+		Accounts: config.DefaultSecretsConfig.DevAccounts()[:1],
+		L1Tokens: []*orchestrator.L1TokenDeployment{{
+			Name: "Test Token", Symbol: "TEST", Decimals: 18, Address: common.HexToAddress("0x0000000000000000000000000000000000000004"),
+			L2Tokens: []*orchestrator.ChainDeployment{{ChainID: 901, Address: common.HexToAddress("0x0000000000000000000000000000000000000005")}},
+		}},
+	}
+}
+
+func TestWriteFoundry(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, testConfigDocument(), FoundryFormat))
+	require.Equal(t, "[rpc_endpoints]\nl1 = \"http://127.0.0.1:8545\"\nopchaina = \"http://127.0.0.1:9545\"\n", b.String())
+}
+
+func TestWriteHardhat(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, testConfigDocument(), HardhatFormat))
+
+	var out struct {
+		Networks map[string]hardhatNetwork `json:"networks"`
+	}
+	require.NoError(t, json.Unmarshal(b.Bytes(), &out))
+	require.Equal(t, uint64(901), out.Networks["opchaina"].ChainID)
+	require.Equal(t, "http://127.0.0.1:8545", out.Networks["l1"].URL)
+	require.Equal(t, []string{"0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80"}, out.Networks["l1"].Accounts)
+}
+
+func TestWriteDotenv(t *testing.T) {
+	var b bytes.Buffer
+	require.NoError(t, Write(&b, testConfigDocument(), DotenvFormat))
+
+	out := b.String()
+	require.Contains(t, out, "SUPERSIM_ADMIN_RPC=http://127.0.0.1:8420\n")
+	require.Contains(t, out, "L1_RPC_URL=http://127.0.0.1:8545\n")
+	require.Contains(t, out, "OP_CHAIN_A_RPC_URL=http://127.0.0.1:9545\n")
+	require.Contains(t, out, "OP_CHAIN_A_CHAIN_ID=901\n")
+	require.Contains(t, out, "OP_CHAIN_A_OPTIMISM_PORTAL_PROXY=0x0000000000000000000000000000000000000001\n")
+	require.Contains(t, out, "OP_CHAIN_A_L1_CROSS_DOMAIN_MESSENGER_PROXY=0x0000000000000000000000000000000000000002\n")
+	require.Contains(t, out, "L2_TO_L2_CROSS_DOMAIN_MESSENGER=0x4200000000000000000000000000000000000023\n")
+	require.Contains(t, out, "MTK_TOKEN=0x0000000000000000000000000000000000000003\n")
+	require.Contains(t, out, "L1_TEST_TOKEN=0x0000000000000000000000000000000000000004\n")
+	require.Contains(t, out, "OP_CHAIN_A_TEST_TOKEN=0x0000000000000000000000000000000000000005\n")
+	require.NotContains(t, out, "SYSTEM_CONFIG_PROXY")
+}
+
+func TestEnvName(t *testing.T) {
+	tests := map[string]string{
+		"OptimismMintableERC20FactoryProxy": "OPTIMISM_MINTABLE_ERC20_FACTORY_PROXY",
+		"CrossL2Inbox":                      "CROSS_L2_INBOX",
+		"SuperchainWETH":                    "SUPERCHAIN_WETH",
+		"op-sepolia":                        "OP_SEPOLIA",
+		"base":                              "BASE",
+	}
+	for name, expected := range tests {
+		require.Equal(t, expected, envName(name), name)
+	}
+}
@@ -0,0 +1,143 @@
+package genesis
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+// Files of a genesis bundle, as written by worldgen, are named by chain id
+const (
+	l1GenesisFileSuffix      = "-l1-genesis.json"
+	l2GenesisFileSuffix      = "-l2-genesis.json"
+	l2AddressesFileSuffix    = "-l2-addresses.json"
+	l2RollupConfigFileSuffix = "-l2-rollup.json"
+
+	// ManifestFileName lists the superchain & per-chain deployments of the bundle
+	ManifestFileName = "manifest.json"
+)
+
+func L1GenesisFileName(chainID uint64) string {
+	return fmt.Sprintf("%d%s", chainID, l1GenesisFileSuffix)
+}
+
+func L2GenesisFileName(chainID uint64) string {
+	return fmt.Sprintf("%d%s", chainID, l2GenesisFileSuffix)
+}
+
+func L2AddressesFileName(chainID uint64) string {
+	return fmt.Sprintf("%d%s", chainID, l2AddressesFileSuffix)
+}
+
+func L2RollupConfigFileName(chainID uint64) string {
+	return fmt.Sprintf("%d%s", chainID, l2RollupConfigFileSuffix)
+}
+
+// LoadGenesisDeployment reads the genesis bundle in the directory. The bundle contains a
+// single L1 genesis along with the genesis & L1 deployment addresses of every L2. The
+// manifest & rollup configs are optional as older bundles were generated without them.
+func LoadGenesisDeployment(dir string) (*GenesisDeployment, error) {
+	deployment, err := loadGenesisDeployment(os.DirFS(dir))
+	if err != nil {
+		return nil, fmt.Errorf("invalid genesis bundle in %s: %w", dir, err)
+	}
+	return deployment, nil
+}
+
+func loadGenesisDeployment(fsys fs.FS) (*GenesisDeployment, error) {
+	l1ChainIDs, err := bundleChainIDs(fsys, l1GenesisFileSuffix)
+	if err != nil {
+		return nil, err
+	}
+	if len(l1ChainIDs) != 1 {
+		return nil, fmt.Errorf("expected a single L1 genesis, found %d", len(l1ChainIDs))
+	}
+
+	l1GenesisJSON, err := fs.ReadFile(fsys, L1GenesisFileName(l1ChainIDs[0]))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read L1 genesis: %w", err)
+	}
+
+	deployment := &GenesisDeployment{L1: &L1GenesisDeployment{ChainID: l1ChainIDs[0], GenesisJSON: l1GenesisJSON}}
+
+	var manifest Manifest
+	if _, err := readOptionalJSON(fsys, ManifestFileName, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	deployment.Superchain = manifest.Superchain
+
+	l2ChainIDs, err := bundleChainIDs(fsys, l2GenesisFileSuffix)
+	if err != nil {
+		return nil, err
+	}
+	if len(l2ChainIDs) == 0 {
+		return nil, errors.New("no L2 genesis found")
+	}
+
+	for _, chainID := range l2ChainIDs {
+		l2GenesisJSON, err := fs.ReadFile(fsys, L2GenesisFileName(chainID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read L2 genesis: %w", err)
+		}
+		addressesJSON, err := fs.ReadFile(fsys, L2AddressesFileName(chainID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read L2 addresses: %w", err)
+		}
+
+		l2Deployment, err := parseL2GenesisDeployment(chainID, addressesJSON, l2GenesisJSON)
+		if err != nil {
+			return nil, fmt.Errorf("invalid L2 %d: %w", chainID, err)
+		}
+
+		var rollupConfig rollup.Config
+		found, err := readOptionalJSON(fsys, L2RollupConfigFileName(chainID), &rollupConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read L2 %d rollup config: %w", chainID, err)
+		}
+		if found {
+			l2Deployment.RollupConfig = &rollupConfig
+		}
+		deployment.L2s = append(deployment.L2s, l2Deployment)
+	}
+
+	return deployment, nil
+}
+
+// readOptionalJSON decodes the file into the value. Returns false, leaving the value untouched, if the file does not exist
+func readOptionalJSON(fsys fs.FS, name string, v interface{}) (bool, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, json.Unmarshal(data, v)
+}
+
+// bundleChainIDs returns the sorted chain ids of the files in the bundle with the suffix
+func bundleChainIDs(fsys fs.FS, suffix string) ([]uint64, error) {
+	matches, err := fs.Glob(fsys, "*"+suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	var chainIDs []uint64
+	for _, match := range matches {
+		id := strings.TrimSuffix(match, suffix)
+		chainID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("unexpected genesis file name %s: %w", match, err)
+		}
+		chainIDs = append(chainIDs, chainID)
+	}
+
+	sort.Slice(chainIDs, func(i, j int) bool { return chainIDs[i] < chainIDs[j] })
+	return chainIDs, nil
+}
@@ -0,0 +1,107 @@
+package genesis
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadGenesisDeployment(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	writeFile(L1GenesisFileName(1000), `{"alloc":{}}`)
+	for _, chainID := range []uint64{2002, 1001} {
+		// granite at genesis, holocene scheduled
+		writeFile(L2GenesisFileName(chainID), `{"config":{"graniteTime":0,"holoceneTime":1100},"timestamp":"0x3e8","alloc":{}}`)
+		writeFile(L2AddressesFileName(chainID), `{"OptimismPortalProxy":"0x0000000000000000000000000000000000000001"}`)
+	}
+
+	deployment, err := LoadGenesisDeployment(dir)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(1000), deployment.L1.ChainID)
+	require.Equal(t, 2, len(deployment.L2s))
+
+	// sorted by chain id
+	require.Equal(t, uint64(1001), deployment.L2s[0].ChainID)
+	require.Equal(t, uint64(2002), deployment.L2s[1].ChainID)
+	require.Equal(t, common.HexToAddress("0x1"), deployment.L2s[0].L1DeploymentAddresses.OptimismPortalProxy)
+	require.Equal(t, "granite", deployment.L2s[0].Hardfork)
+	require.False(t, deployment.L2s[0].Interop)
+}
+
+func TestParseL2GenesisDeploymentScheduledInterop(t *testing.T) {
+	// granite at genesis, interop scheduled
+	deployment, err := parseL2GenesisDeployment(901, []byte(`{}`), []byte(`{"config":{"graniteTime":0,"interopTime":7200},"timestamp":"0x0","alloc":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, "granite", deployment.Hardfork)
+	require.True(t, deployment.Interop)
+}
+
+func TestLoadGenesisDeploymentMissingFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	// no L1 genesis
+	_, err := LoadGenesisDeployment(dir)
+	require.Error(t, err)
+
+	// no L2 addresses
+	require.NoError(t, os.WriteFile(filepath.Join(dir, L1GenesisFileName(1000)), []byte(`{}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, L2GenesisFileName(1001)), []byte(`{}`), 0644))
+	_, err = LoadGenesisDeployment(dir)
+	require.Error(t, err)
+}
+
+func TestLoadGenesisDeploymentManifest(t *testing.T) {
+	dir := t.TempDir()
+
+	writeFile := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	writeFile(L1GenesisFileName(1000), `{"alloc":{}}`)
+	writeFile(L2GenesisFileName(1001), `{"alloc":{}}`)
+	writeFile(L2AddressesFileName(1001), `{"SystemConfigProxy":"0x0000000000000000000000000000000000000002"}`)
+	writeFile(L2RollupConfigFileName(1001), `{"l2_chain_id":1001,"block_time":2}`)
+	writeFile(ManifestFileName, `{"l1ChainID":1000,"superchain":{"SuperchainConfigProxy":"0x0000000000000000000000000000000000000003"}}`)
+
+	deployment, err := LoadGenesisDeployment(dir)
+	require.NoError(t, err)
+
+	manifest := deployment.Manifest()
+	require.Equal(t, uint64(1000), manifest.L1ChainID)
+	require.Equal(t, common.HexToAddress("0x3"), manifest.Superchain.SuperchainConfigProxy)
+	require.Equal(t, 1, len(manifest.L2s))
+	require.Equal(t, uint64(1001), manifest.L2s[0].ChainID)
+	require.Equal(t, common.HexToAddress("0x2"), manifest.L2s[0].Addresses.SystemConfigProxy)
+	require.Equal(t, uint64(2), manifest.L2s[0].RollupConfig.BlockTime)
+
+	// bundles without a manifest or rollup configs
+	require.NoError(t, os.Remove(filepath.Join(dir, ManifestFileName)))
+	require.NoError(t, os.Remove(filepath.Join(dir, L2RollupConfigFileName(1001))))
+
+	deployment, err = LoadGenesisDeployment(dir)
+	require.NoError(t, err)
+
+	manifest = deployment.Manifest()
+	require.Nil(t, manifest.Superchain)
+	require.Nil(t, manifest.L2s[0].RollupConfig)
+	require.Equal(t, common.HexToAddress("0x2"), manifest.L2s[0].Addresses.SystemConfigProxy)
+}
+
+func TestRegistryAddressListFaultProofs(t *testing.T) {
+	for _, l2 := range GeneratedGenesisDeployment.L2s {
+		addresses := l2.RegistryAddressList()
+		require.Equal(t, l2.Addresses.DisputeGameFactoryProxy, common.Address(addresses.DisputeGameFactoryProxy))
+		require.NotEqual(t, common.Address{}, common.Address(addresses.DisputeGameFactoryProxy))
+		require.NotEqual(t, common.Address{}, common.Address(addresses.AnchorStateRegistryProxy))
+		require.NotEqual(t, common.Address{}, common.Address(addresses.PermissionedDisputeGame))
+	}
+}
@@ -1,12 +1,18 @@
 package genesis
 
 import (
-	_ "embed"
+	"embed"
 	"encoding/json"
 	"fmt"
+	"io/fs"
 
 	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/interopgen"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
 	registry "github.com/ethereum-optimism/superchain-registry/superchain"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/params"
 )
 
 type GenesisJson struct {
@@ -20,65 +26,32 @@ type Alloc struct {
 	Storage map[string]string `json:"storage"`
 }
 
-/*******************
-L1 Genesis files
-*******************/
-//go:embed generated/900-l1-genesis.json
-var l1GenesisJSON []byte
-
-/*******************
-L2 Genesis files
-*******************/
-//go:embed generated/901-l2-genesis.json
-var l2Genesis901JSON []byte
-
-//go:embed generated/902-l2-genesis.json
-var l2Genesis902JSON []byte
-
-//go:embed generated/903-l2-genesis.json
-var l2Genesis903JSON []byte
-
-//go:embed generated/904-l2-genesis.json
-var l2Genesis904JSON []byte
-
-//go:embed generated/905-l2-genesis.json
-var l2Genesis905JSON []byte
-
-/*******************
-L2 Addresses files
-*******************/
-//go:embed generated/901-l2-addresses.json
-var addresses901JSON []byte
-
-//go:embed generated/902-l2-addresses.json
-var addresses902JSON []byte
-
-//go:embed generated/903-l2-addresses.json
-var addresses903JSON []byte
-
-//go:embed generated/904-l2-addresses.json
-var addresses904JSON []byte
-
-//go:embed generated/905-l2-addresses.json
-var addresses905JSON []byte
-
-var GeneratedGenesisDeployment = &GenesisDeployment{
-	L1: &L1GenesisDeployment{
-		ChainID:     900,
-		GenesisJSON: l1GenesisJSON,
-	},
-	L2s: []*L2GenesisDeployment{
-		newL2GenesisDeployment(901, addresses901JSON, l2Genesis901JSON),
-		newL2GenesisDeployment(902, addresses902JSON, l2Genesis902JSON),
-		newL2GenesisDeployment(903, addresses903JSON, l2Genesis903JSON),
-		newL2GenesisDeployment(904, addresses904JSON, l2Genesis904JSON),
-		newL2GenesisDeployment(905, addresses905JSON, l2Genesis905JSON),
-	},
+// The bundle written by worldgen, see `just generate-genesis`. Any manifest & rollup configs are embedded along
+// with the genesis files
+//
+//go:embed generated
+var generatedFS embed.FS
+
+var GeneratedGenesisDeployment = mustLoadGeneratedGenesisDeployment()
+
+func mustLoadGeneratedGenesisDeployment() *GenesisDeployment {
+	fsys, err := fs.Sub(generatedFS, "generated")
+	if err != nil {
+		panic(err.Error())
+	}
+	deployment, err := loadGenesisDeployment(fsys)
+	if err != nil {
+		panic(fmt.Sprintf("invalid embedded genesis: %s", err))
+	}
+	return deployment
 }
 
 type GenesisDeployment struct {
 	L1  *L1GenesisDeployment
 	L2s []*L2GenesisDeployment
+
+	// Optional, superchain contracts deployed to the L1
+	Superchain *interopgen.SuperchainDeployment
 }
 
 type L1GenesisDeployment struct {
@@ -90,9 +63,19 @@ type L2GenesisDeployment struct {
 	ChainID               uint64
 	GenesisJSON           []byte
 	L1DeploymentAddresses *genesis.L1Deployments
+	Addresses             *interopgen.L2Deployment
+
+	// Optional
+	RollupConfig *rollup.Config
+
+	// Latest OP Stack hardfork active at genesis, empty if none are configured
+	Hardfork string
+
+	// Whether interop activates, at genesis or at a later scheduled time
+	Interop bool
 }
 
-// Unassigned contracts -- Fault Proof, Plasma, SuperchainConfig, Roles
+// Unassigned contracts -- Plasma, SuperchainConfig, Roles
 //
 // NOTE: We use the superchain registry AddressList as the canonical format for superchain
 // addresses. Any experimental contracts will be managed externally from this list. The registry
@@ -109,20 +92,69 @@ func (d *L2GenesisDeployment) RegistryAddressList() *registry.AddressList {
 		OptimismPortalProxy:               registry.Address(d.L1DeploymentAddresses.OptimismPortalProxy),
 		SystemConfigProxy:                 registry.Address(d.L1DeploymentAddresses.SystemConfigProxy),
 		ProxyAdmin:                        registry.Address(d.L1DeploymentAddresses.ProxyAdmin),
+
+		// Fault Proof contracts. Only the permissioned game type is deployed
+		DisputeGameFactoryProxy:  registry.Address(d.Addresses.DisputeGameFactoryProxy),
+		AnchorStateRegistryProxy: registry.Address(d.Addresses.AnchorStateRegistryProxy),
+		FaultDisputeGame:         registry.Address(d.Addresses.FaultDisputeGame),
+		PermissionedDisputeGame:  registry.Address(d.Addresses.PermissionedDisputeGame),
+		DelayedWETHProxy:         registry.Address(d.Addresses.DelayedWETHPermissionedGameProxy),
 	}
 }
 
-func newL2GenesisDeployment(l2ChainID uint64, l1DeploymentAddressesJSON []byte, l2GenesisJSON []byte) *L2GenesisDeployment {
+func parseL2GenesisDeployment(l2ChainID uint64, l1DeploymentAddressesJSON []byte, l2GenesisJSON []byte) (*L2GenesisDeployment, error) {
 	var l1DeploymentAddresses genesis.L1Deployments
 	if err := json.Unmarshal(l1DeploymentAddressesJSON, &l1DeploymentAddresses); err != nil {
-		panic(fmt.Sprintf("failed to unmarshal L1 deployment addresses: %v", err))
+		return nil, fmt.Errorf("failed to unmarshal L1 deployment addresses: %w", err)
+	}
+
+	var addresses interopgen.L2Deployment
+	if err := json.Unmarshal(l1DeploymentAddressesJSON, &addresses); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal L2 deployment addresses: %w", err)
+	}
+
+	var l2Genesis struct {
+		Config    *params.ChainConfig `json:"config"`
+		Timestamp hexutil.Uint64      `json:"timestamp"`
+	}
+	if err := json.Unmarshal(l2GenesisJSON, &l2Genesis); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal L2 genesis: %w", err)
 	}
 
 	return &L2GenesisDeployment{
 		ChainID:               l2ChainID,
 		GenesisJSON:           l2GenesisJSON,
 		L1DeploymentAddresses: &l1DeploymentAddresses,
+		Addresses:             &addresses,
+		Hardfork:              genesisHardfork(l2Genesis.Config, uint64(l2Genesis.Timestamp)),
+		Interop:               l2Genesis.Config != nil && l2Genesis.Config.InteropTime != nil,
+	}, nil
+}
+
+// genesisHardfork returns the latest OP Stack hardfork active at the genesis timestamp
+func genesisHardfork(cfg *params.ChainConfig, timestamp uint64) string {
+	if cfg == nil {
+		return ""
+	}
+
+	hardforks := []struct {
+		name string
+		time *uint64
+	}{
+		{"interop", cfg.InteropTime},
+		{"holocene", cfg.HoloceneTime},
+		{"granite", cfg.GraniteTime},
+		{"fjord", cfg.FjordTime},
+		{"ecotone", cfg.EcotoneTime},
+		{"canyon", cfg.CanyonTime},
+		{"regolith", cfg.RegolithTime},
+	}
+	for _, hardfork := range hardforks {
+		if hardfork.time != nil && *hardfork.time <= timestamp {
+			return hardfork.name
+		}
 	}
+	return ""
 }
 
 func UnMarshaledL2GenesisJSON() (*GenesisJson, error) {
@@ -0,0 +1,33 @@
+package genesis
+
+import (
+	"github.com/ethereum-optimism/optimism/op-chain-ops/interopgen"
+	"github.com/ethereum-optimism/optimism/op-node/rollup"
+)
+
+// Manifest lists the superchain & per-chain deployments of a genesis bundle
+type Manifest struct {
+	L1ChainID uint64 `json:"l1ChainID"`
+
+	// Not known for bundles generated before the manifest was written
+	Superchain *interopgen.SuperchainDeployment `json:"superchain,omitempty"`
+
+	L2s []*L2Manifest `json:"l2s"`
+}
+
+type L2Manifest struct {
+	ChainID   uint64                   `json:"chainID"`
+	Addresses *interopgen.L2Deployment `json:"addresses"`
+
+	// Not known for bundles generated without rollup configs
+	RollupConfig *rollup.Config `json:"rollupConfig,omitempty"`
+}
+
+// Manifest returns the manifest of the deployment
+func (d *GenesisDeployment) Manifest() *Manifest {
+	manifest := &Manifest{L1ChainID: d.L1.ChainID, Superchain: d.Superchain}
+	for _, l2 := range d.L2s {
+		manifest.L2s = append(manifest.L2s, &L2Manifest{ChainID: l2.ChainID, Addresses: l2.Addresses, RollupConfig: l2.RollupConfig})
+	}
+	return manifest
+}
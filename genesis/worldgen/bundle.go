@@ -0,0 +1,227 @@
+package worldgen
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/interopgen"
+	"github.com/ethereum-optimism/optimism/op-deployer/pkg/deployer/opcm"
+	"github.com/ethereum-optimism/optimism/op-deployer/pkg/deployer/pipeline"
+	"github.com/ethereum-optimism/optimism/op-service/ioutil"
+	"github.com/ethereum-optimism/optimism/op-service/jsonutil"
+	"github.com/ethereum-optimism/supersim/genesis"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// Artifacts locates the contract artifacts used to generate a world
+type Artifacts struct {
+	MonorepoURL  *url.URL
+	PeripheryURL *url.URL
+}
+
+// GenerateBundle generates the world described by the recipe and writes the genesis bundle to the output directory.
+// The optional user genesis is included in every L2.
+func GenerateBundle(ctx context.Context, logger log.Logger, recipe *Recipe, artifacts *Artifacts, userGenesis *UserGenesis, outdir string) error {
+	monorepoArtifactsFS, monorepoArtifactsCleanup, err := downloadArtifacts(ctx, logger, "monorepo", artifacts.MonorepoURL)
+	if err != nil {
+		return fmt.Errorf("failed to download monorepo artifacts: %w", err)
+	}
+	defer func() {
+		if err := monorepoArtifactsCleanup(); err != nil {
+			logger.Warn("failed to clean up monorepo artifacts", "err", err)
+		}
+	}()
+
+	peripheryArtifactsFS, peripheryArtifactsCleanup, err := downloadArtifacts(ctx, logger, "periphery", artifacts.PeripheryURL)
+	if err != nil {
+		return fmt.Errorf("failed to download periphery artifacts: %w", err)
+	}
+	defer func() {
+		if err := peripheryArtifactsCleanup(); err != nil {
+			logger.Warn("failed to clean up periphery artifacts", "err", err)
+		}
+	}()
+
+	var userArtifacts *foundry.ArtifactsFS
+	if userGenesis != nil && userGenesis.ArtifactsURL != nil {
+		userArtifactsFS, userArtifactsCleanup, err := downloadArtifacts(ctx, logger, "user", userGenesis.ArtifactsURL)
+		if err != nil {
+			return fmt.Errorf("failed to download user artifacts: %w", err)
+		}
+		defer func() {
+			if err := userArtifactsCleanup(); err != nil {
+				logger.Warn("failed to clean up user artifacts", "err", err)
+			}
+		}()
+		userArtifacts = &foundry.ArtifactsFS{FS: userArtifactsFS}
+	}
+
+	worldDeployment, worldOutput, err := GenerateWorld(ctx, logger, recipe, &foundry.ArtifactsFS{FS: monorepoArtifactsFS}, &foundry.ArtifactsFS{FS: peripheryArtifactsFS}, userArtifacts, userGenesis)
+	if err != nil {
+		return fmt.Errorf("failed to generate world: %w", err)
+	}
+
+	logger.Info("successfully generated world")
+	return WriteBundle(logger, outdir, worldDeployment, worldOutput)
+}
+
+// WriteBundle writes the L1 genesis, the genesis, rollup config & L1 deployment addresses of
+// every L2, and the manifest of all deployments to the output directory
+func WriteBundle(logger log.Logger, outdir string, worldDeployment *interopgen.WorldDeployment, worldOutput *interopgen.WorldOutput) error {
+	if err := os.MkdirAll(outdir, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+
+	logger.Info("writing L1 genesis")
+	l1ChainID := worldOutput.L1.Genesis.Config.ChainID.Uint64()
+	outfile := genesis.L1GenesisFileName(l1ChainID)
+	if err := jsonutil.WriteJSON(worldOutput.L1.Genesis, ioutil.ToStdOutOrFileOrNoop(filepath.Join(outdir, outfile), 0o666)); err != nil {
+		return fmt.Errorf("failed to write L1 genesis: %w", err)
+	}
+
+	manifest := &genesis.Manifest{L1ChainID: l1ChainID, Superchain: worldDeployment.Superchain}
+	for l2ChainID, l2Deployment := range worldDeployment.L2s {
+		chainID, err := strconv.ParseUint(l2ChainID, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid l2 chain id %s: %w", l2ChainID, err)
+		}
+
+		logger.Info("writing addresses for l2", "chain_id", l2ChainID)
+		if err := jsonutil.WriteJSON(l2Deployment, ioutil.ToStdOutOrFileOrNoop(filepath.Join(outdir, genesis.L2AddressesFileName(chainID)), 0o666)); err != nil {
+			return fmt.Errorf("failed to write addresses: %w", err)
+		}
+
+		logger.Info("writing genesis for l2", "chain_id", l2ChainID)
+		if err := jsonutil.WriteJSON(worldOutput.L2s[l2ChainID].Genesis, ioutil.ToStdOutOrFileOrNoop(filepath.Join(outdir, genesis.L2GenesisFileName(chainID)), 0o666)); err != nil {
+			return fmt.Errorf("failed to write genesis: %w", err)
+		}
+
+		logger.Info("writing rollup config for l2", "chain_id", l2ChainID)
+		rollupConfig := worldOutput.L2s[l2ChainID].RollupCfg
+		if err := jsonutil.WriteJSON(rollupConfig, ioutil.ToStdOutOrFileOrNoop(filepath.Join(outdir, genesis.L2RollupConfigFileName(chainID)), 0o666)); err != nil {
+			return fmt.Errorf("failed to write rollup config: %w", err)
+		}
+
+		manifest.L2s = append(manifest.L2s, &genesis.L2Manifest{ChainID: chainID, Addresses: l2Deployment, RollupConfig: rollupConfig})
+	}
+
+	logger.Info("writing manifest")
+	slices.SortFunc(manifest.L2s, func(a, b *genesis.L2Manifest) int { return cmp.Compare(a.ChainID, b.ChainID) })
+	if err := jsonutil.WriteJSON(manifest, ioutil.ToStdOutOrFileOrNoop(filepath.Join(outdir, genesis.ManifestFileName), 0o666)); err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+
+	return nil
+}
+
+// LoadOrGenerateDeployment returns the genesis deployment of the recipe from the cache directory, generating
+// and caching the bundle if not present. A zero genesis timestamp is filled in with the current time when generating.
+func LoadOrGenerateDeployment(ctx context.Context, logger log.Logger, recipe *Recipe, artifacts *Artifacts, userGenesis *UserGenesis, cacheDir string) (*genesis.GenesisDeployment, error) {
+	key, err := bundleKey(recipe, artifacts, userGenesis)
+	if err != nil {
+		return nil, err
+	}
+
+	bundleDir := filepath.Join(cacheDir, key)
+	if _, err := os.Stat(filepath.Join(bundleDir, genesis.L1GenesisFileName(recipe.L1ChainID))); err == nil {
+		logger.Info("using cached genesis bundle", "dir", bundleDir)
+		return genesis.LoadGenesisDeployment(bundleDir)
+	}
+
+	if artifacts.MonorepoURL == nil || artifacts.PeripheryURL == nil {
+		return nil, errors.New("monorepo and periphery artifacts are required to generate the genesis")
+	}
+
+	generateRecipe := *recipe
+	if generateRecipe.GenesisTimestamp == 0 {
+		generateRecipe.GenesisTimestamp = uint64(time.Now().Unix())
+	}
+
+	// generate into a temporary directory such that a failed generation is never cached
+	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create genesis cache directory: %w", err)
+	}
+	tmpDir, err := os.MkdirTemp(cacheDir, key+".*.tmp")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	logger.Info("generating genesis", "l1.chain.id", recipe.L1ChainID, "l2.chain.ids", recipe.L2ChainIDs)
+	if err := GenerateBundle(ctx, logger, &generateRecipe, artifacts, userGenesis, tmpDir); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpDir, bundleDir); err != nil {
+		return nil, fmt.Errorf("failed to cache genesis bundle: %w", err)
+	}
+
+	return genesis.LoadGenesisDeployment(bundleDir)
+}
+
+// bundleKey identifies the bundle generated from the recipe, artifacts & user genesis. The content
+// of local user inputs is part of the key as it changes as the user project is iterated on.
+func bundleKey(recipe *Recipe, artifacts *Artifacts, userGenesis *UserGenesis) (string, error) {
+	var monorepoURL, peripheryURL string
+	if artifacts.MonorepoURL != nil {
+		monorepoURL = artifacts.MonorepoURL.String()
+	}
+	if artifacts.PeripheryURL != nil {
+		peripheryURL = artifacts.PeripheryURL.String()
+	}
+
+	var userArtifactsURL, userScript string
+	var userArtifactsHash, userAllocs []byte
+	if userGenesis != nil {
+		if userGenesis.ArtifactsURL != nil {
+			userArtifactsURL = userGenesis.ArtifactsURL.String()
+		}
+		userScript = userGenesis.Script
+
+		var err error
+		if userArtifactsHash, err = hashLocalArtifacts(userGenesis.ArtifactsURL); err != nil {
+			return "", err
+		}
+		if userGenesis.AllocsPath != "" {
+			if userAllocs, err = os.ReadFile(userGenesis.AllocsPath); err != nil {
+				return "", fmt.Errorf("failed to read user allocs: %w", err)
+			}
+		}
+	}
+
+	data, err := json.Marshal(struct {
+		Recipe            *Recipe
+		MonorepoURL       string
+		PeripheryURL      string
+		UserArtifactsURL  string `json:",omitempty"`
+		UserArtifactsHash []byte `json:",omitempty"`
+		UserScript        string `json:",omitempty"`
+		UserAllocs        []byte `json:",omitempty"`
+	}{recipe, monorepoURL, peripheryURL, userArtifactsURL, userArtifactsHash, userScript, userAllocs})
+	if err != nil {
+		return "", fmt.Errorf("failed to encode recipe: %w", err)
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:8]), nil
+}
+
+func downloadArtifacts(ctx context.Context, logger log.Logger, name string, artifactsURL *url.URL) (foundry.StatDirFs, pipeline.CleanupFunc, error) {
+	progressor := func(curr, total int64) {
+		logger.Info(fmt.Sprintf("%s artifacts download progress", name), "current", curr, "total", total)
+	}
+
+	return pipeline.DownloadArtifacts(ctx, &opcm.ArtifactsLocator{URL: artifactsURL}, progressor)
+}
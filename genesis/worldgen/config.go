@@ -5,9 +5,11 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"time"
 
 	"path/filepath"
 
+	"github.com/ethereum-optimism/optimism/op-chain-ops/interopgen"
 	"github.com/urfave/cli/v2"
 )
 
@@ -23,25 +25,75 @@ const (
 	MonorepoArtifactsUrlFlag  = "monorepo-artifacts"
 	PeripheryArtifactsUrlFlag = "periphery-artifacts"
 	OutDirFlag                = "outdir"
+	L1ChainIDFlag             = "l1-chain-id"
+	L2ChainIDsFlag            = "l2-chain-ids"
+	GenesisTimestampFlag      = "genesis-timestamp"
+	HardforkFlag              = "hardfork"
+	HardforkActivationsFlag   = "hardfork-activations"
+	UserArtifactsUrlFlag      = "user-artifacts"
+	UserScriptFlag            = "user-script"
+	UserAllocsFlag            = "user-allocs"
+)
+
+// The artifacts of a supersim checkout, used when no artifacts are specified. The monorepo contracts are built
+// with forge in the optimism submodule and the periphery contracts with `just build-contracts`
+const (
+	DefaultMonorepoArtifacts  = "contracts/lib/optimism/packages/contracts-bedrock/forge-artifacts"
+	DefaultPeripheryArtifacts = "contracts/out"
 )
 
 func CLIFlags() []cli.Flag {
 	return []cli.Flag{
 		&cli.StringFlag{
-			Name:     MonorepoArtifactsUrlFlag,
-			Usage:    "URL to the monorepo artifacts, can be https:// or a fs path",
-			Required: true,
+			Name:  MonorepoArtifactsUrlFlag,
+			Usage: "URL to the monorepo artifacts, can be https:// or a fs path",
+			Value: DefaultMonorepoArtifacts,
 		},
 		&cli.StringFlag{
-			Name:     PeripheryArtifactsUrlFlag,
-			Usage:    "URL to the periphery artifacts, can be https:// or a fs path",
-			Required: true,
+			Name:  PeripheryArtifactsUrlFlag,
+			Usage: "URL to the periphery artifacts, can be https:// or a fs path",
+			Value: DefaultPeripheryArtifacts,
 		},
 		&cli.StringFlag{
 			Name:  OutDirFlag,
 			Usage: "Directory to output the genesis files",
 			Value: cwd(),
 		},
+		&cli.Uint64Flag{
+			Name:  L1ChainIDFlag,
+			Usage: "Chain ID of the L1",
+			Value: DefaultL1ChainID,
+		},
+		&cli.Uint64SliceFlag{
+			Name:  L2ChainIDsFlag,
+			Usage: "Chain IDs of the L2s. Defaults to the chains of per-chain user allocs when specified",
+			Value: cli.NewUint64Slice(DefaultL2ChainIDs...),
+		},
+		&cli.Uint64Flag{
+			Name:  GenesisTimestampFlag,
+			Usage: "Genesis timestamp of the chains. `0` for the current time",
+		},
+		&cli.StringFlag{
+			Name:  HardforkFlag,
+			Usage: fmt.Sprintf("OP Stack hardfork of the L2s at genesis, along with every prior hardfork. Supported: [%s]", strings.Join(Hardforks, ", ")),
+			Value: DefaultHardfork,
+		},
+		&cli.StringSliceFlag{
+			Name:  HardforkActivationsFlag,
+			Usage: "Activation of hardforks after the genesis hardfork, in seconds after genesis. i.e holocene=3600,interop=7200",
+		},
+		&cli.StringFlag{
+			Name:  UserArtifactsUrlFlag,
+			Usage: "URL to the foundry artifacts of the user contracts, can be https:// or a fs path",
+		},
+		&cli.StringFlag{
+			Name:  UserScriptFlag,
+			Usage: "Script, `<file>:<contract>`, in the user artifacts whose run() deploys the user contracts into every L2 genesis. i.e Deploy.s.sol:Deploy",
+		},
+		&cli.StringFlag{
+			Name:  UserAllocsFlag,
+			Usage: "Path to a JSON of accounts, in the format of a genesis alloc, included in every L2 genesis. Or a JSON of L2 chain id to genesis alloc",
+		},
 	}
 }
 
@@ -49,6 +101,11 @@ type CLIConfig struct {
 	MonorepoArtifactsURL  *url.URL
 	PeripheryArtifactsURL *url.URL
 	Outdir                string
+
+	Recipe *Recipe
+
+	// Optional
+	UserGenesis *UserGenesis
 }
 
 func ParseCLIConfig(ctx *cli.Context) (*CLIConfig, error) {
@@ -56,24 +113,101 @@ func ParseCLIConfig(ctx *cli.Context) (*CLIConfig, error) {
 	peripheryArtifacts := ctx.String(PeripheryArtifactsUrlFlag)
 	outDir := ctx.String(OutDirFlag)
 
-	monorepoArtifactsURL, err := parseLocalPathOrURL(monorepoArtifacts)
+	monorepoArtifactsURL, err := ParseLocalPathOrURL(monorepoArtifacts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse monorepo artifacts url: %w", err)
 	}
 
-	peripheryArtifactsURL, err := parseLocalPathOrURL(peripheryArtifacts)
+	peripheryArtifactsURL, err := ParseLocalPathOrURL(peripheryArtifacts)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse periphery artifacts url: %w", err)
 	}
 
+	var userGenesis *UserGenesis
+	if ctx.IsSet(UserArtifactsUrlFlag) || ctx.IsSet(UserScriptFlag) || ctx.IsSet(UserAllocsFlag) {
+		userGenesis = &UserGenesis{Script: ctx.String(UserScriptFlag), AllocsPath: ctx.String(UserAllocsFlag)}
+		if ctx.IsSet(UserArtifactsUrlFlag) {
+			userGenesis.ArtifactsURL, err = ParseLocalPathOrURL(ctx.String(UserArtifactsUrlFlag))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse user artifacts url: %w", err)
+			}
+		}
+		if err := userGenesis.Check(); err != nil {
+			return nil, err
+		}
+	}
+
+	recipe := &Recipe{
+		InteropDevRecipe: interopgen.InteropDevRecipe{
+			L1ChainID:        ctx.Uint64(L1ChainIDFlag),
+			L2ChainIDs:       ctx.Uint64Slice(L2ChainIDsFlag),
+			GenesisTimestamp: ctx.Uint64(GenesisTimestampFlag),
+		},
+	}
+	if ctx.IsSet(HardforkFlag) || ctx.IsSet(HardforkActivationsFlag) {
+		activations, err := ParseHardforkActivations(ctx.StringSlice(HardforkActivationsFlag))
+		if err != nil {
+			return nil, err
+		}
+		recipe.Hardforks = &HardforkSchedule{Genesis: strings.ToLower(ctx.String(HardforkFlag)), Activations: activations}
+	}
+	// per-chain user allocs determine the L2s when not specified
+	if userGenesis != nil && userGenesis.AllocsPath != "" {
+		userAllocs, err := LoadUserAllocs(userGenesis.AllocsPath)
+		if err != nil {
+			return nil, err
+		}
+		if chainIDs := userAllocs.ChainIDs(); len(chainIDs) > 0 && !ctx.IsSet(L2ChainIDsFlag) {
+			recipe.L2ChainIDs = chainIDs
+		}
+		if err := userAllocs.Check(recipe.L2ChainIDs); err != nil {
+			return nil, err
+		}
+	}
+	if recipe.GenesisTimestamp == 0 {
+		recipe.GenesisTimestamp = uint64(time.Now().Unix())
+	}
+	if err := CheckRecipe(recipe); err != nil {
+		return nil, err
+	}
+
 	return &CLIConfig{
 		MonorepoArtifactsURL:  monorepoArtifactsURL,
 		PeripheryArtifactsURL: peripheryArtifactsURL,
 		Outdir:                outDir,
+		Recipe:                recipe,
+		UserGenesis:           userGenesis,
 	}, nil
 }
 
-func parseLocalPathOrURL(localPathOrUrl string) (*url.URL, error) {
+// CheckRecipe ensures the recipe contains at least one L2, that all chain IDs are unique and that the hardfork schedule is valid
+func CheckRecipe(recipe *Recipe) error {
+	if recipe.L1ChainID == 0 {
+		return fmt.Errorf("l1 chain id must be non-zero")
+	}
+	if len(recipe.L2ChainIDs) == 0 {
+		return fmt.Errorf("at least one l2 chain id is required")
+	}
+
+	chainIDs := map[uint64]bool{recipe.L1ChainID: true}
+	for _, chainID := range recipe.L2ChainIDs {
+		if chainID == 0 {
+			return fmt.Errorf("l2 chain id must be non-zero")
+		}
+		if chainIDs[chainID] {
+			return fmt.Errorf("duplicate chain id %d", chainID)
+		}
+		chainIDs[chainID] = true
+	}
+
+	if recipe.Hardforks != nil {
+		return recipe.Hardforks.Check()
+	}
+	return nil
+}
+
+// ParseLocalPathOrURL parses an http(s):// or file:// url, or a local path as a file:// url
+func ParseLocalPathOrURL(localPathOrUrl string) (*url.URL, error) {
 	if localPathOrUrl == "" {
 		return nil, fmt.Errorf("local path or url is required")
 	}
@@ -32,6 +32,9 @@ const (
 
 	// AutoRelayerSenderRole is the sender of the interop.autorelayer transactions
 	AutoRelayerSenderRole = 1
+
+	// UserContractsDeployerRole is the sender of the user deploy script run when generating the genesis
+	UserContractsDeployerRole = 2
 )
 
 func (role SupersimDevOperatorRole) String() string {
@@ -40,6 +43,8 @@ func (role SupersimDevOperatorRole) String() string {
 		return "periphery-contracts-deployer"
 	case AutoRelayerSenderRole:
 		return "auto-relayer-sender"
+	case UserContractsDeployerRole:
+		return "user-contracts-deployer"
 	default:
 		return fmt.Sprintf("unknown-operator-%d", uint64(role))
 	}
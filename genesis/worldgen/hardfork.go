@@ -0,0 +1,114 @@
+package worldgen
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Hardforks are the OP Stack hardforks, in activation order, that can be configured in a generated L2 genesis
+var Hardforks = []string{"regolith", "canyon", "delta", "ecotone", "fjord", "granite", "holocene", "interop"}
+
+// DefaultHardfork is active at genesis when no hardfork schedule is specified
+const DefaultHardfork = "interop"
+
+// HardforkSchedule configures the hardforks of the generated L2s
+type HardforkSchedule struct {
+	// Hardfork active at genesis, along with every prior hardfork
+	Genesis string `json:"genesis"`
+
+	// Activation of later hardforks, in seconds after genesis
+	Activations map[string]uint64 `json:"activations,omitempty"`
+}
+
+// ParseHardforkActivations parses `<hardfork>=<seconds after genesis>` pairs
+func ParseHardforkActivations(values []string) (map[string]uint64, error) {
+	activations := make(map[string]uint64, len(values))
+	for _, value := range values {
+		name, offset, found := strings.Cut(value, "=")
+		if !found {
+			return nil, fmt.Errorf("invalid hardfork activation %q, expected <hardfork>=<seconds after genesis>", value)
+		}
+
+		seconds, err := strconv.ParseUint(offset, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid activation offset of hardfork %s: %w", name, err)
+		}
+		activations[strings.ToLower(name)] = seconds
+	}
+	return activations, nil
+}
+
+// Check ensures the hardforks are known and that later hardforks are scheduled, in order, after the genesis hardfork
+func (s *HardforkSchedule) Check() error {
+	genesisIndex := slices.Index(Hardforks, s.Genesis)
+	if genesisIndex < 0 {
+		return fmt.Errorf("unsupported genesis hardfork %q, supported hardforks: [%s]", s.Genesis, strings.Join(Hardforks, ", "))
+	}
+
+	for name, offset := range s.Activations {
+		index := slices.Index(Hardforks, name)
+		if index < 0 {
+			return fmt.Errorf("unsupported hardfork %q, supported hardforks: [%s]", name, strings.Join(Hardforks, ", "))
+		}
+		if index <= genesisIndex {
+			return fmt.Errorf("hardfork %s is active at genesis and cannot be scheduled", name)
+		}
+		if offset == 0 {
+			return fmt.Errorf("hardfork %s must activate after genesis", name)
+		}
+	}
+
+	// scheduled hardforks must follow one another without gaps
+	var prevOffset uint64
+	for i := genesisIndex + 1; i < len(Hardforks); i++ {
+		offset, ok := s.Activations[Hardforks[i]]
+		if !ok {
+			for _, later := range Hardforks[i+1:] {
+				if _, ok := s.Activations[later]; ok {
+					return fmt.Errorf("hardfork %s is scheduled but prior hardfork %s is not", later, Hardforks[i])
+				}
+			}
+			break
+		}
+		if offset < prevOffset {
+			return fmt.Errorf("hardfork %s activates before prior hardfork %s", Hardforks[i], Hardforks[i-1])
+		}
+		prevOffset = offset
+	}
+
+	return nil
+}
+
+// apply overrides the upgrade schedule of an L2 deploy config
+func (s *HardforkSchedule) apply(cfg *genesis.UpgradeScheduleDeployConfig) {
+	offsets := []**hexutil.Uint64{
+		&cfg.L2GenesisRegolithTimeOffset,
+		&cfg.L2GenesisCanyonTimeOffset,
+		&cfg.L2GenesisDeltaTimeOffset,
+		&cfg.L2GenesisEcotoneTimeOffset,
+		&cfg.L2GenesisFjordTimeOffset,
+		&cfg.L2GenesisGraniteTimeOffset,
+		&cfg.L2GenesisHoloceneTimeOffset,
+		&cfg.L2GenesisInteropTimeOffset,
+	}
+
+	genesisIndex := slices.Index(Hardforks, s.Genesis)
+	for i, name := range Hardforks {
+		switch offset, ok := s.Activations[name]; {
+		case i <= genesisIndex:
+			*offsets[i] = new(hexutil.Uint64)
+		case ok:
+			*offsets[i] = (*hexutil.Uint64)(&offset)
+		default:
+			*offsets[i] = nil
+		}
+	}
+
+	// interop predeploys are included if interop is ever activated
+	cfg.UseInterop = cfg.L2GenesisInteropTimeOffset != nil
+}
@@ -0,0 +1,52 @@
+package worldgen
+
+import (
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/genesis"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHardforkActivations(t *testing.T) {
+	activations, err := ParseHardforkActivations([]string{"Holocene=3600", "interop=7200"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"holocene": 3600, "interop": 7200}, activations)
+
+	_, err = ParseHardforkActivations([]string{"holocene"})
+	require.Error(t, err)
+	_, err = ParseHardforkActivations([]string{"holocene=soon"})
+	require.Error(t, err)
+}
+
+func TestHardforkScheduleCheck(t *testing.T) {
+	require.NoError(t, (&HardforkSchedule{Genesis: "granite"}).Check())
+	require.NoError(t, (&HardforkSchedule{Genesis: "granite", Activations: map[string]uint64{"holocene": 60, "interop": 60}}).Check())
+
+	// unknown hardforks
+	require.Error(t, (&HardforkSchedule{Genesis: "isthmus"}).Check())
+	require.Error(t, (&HardforkSchedule{Genesis: "granite", Activations: map[string]uint64{"isthmus": 60}}).Check())
+
+	// already active at genesis
+	require.Error(t, (&HardforkSchedule{Genesis: "granite", Activations: map[string]uint64{"fjord": 60}}).Check())
+	require.Error(t, (&HardforkSchedule{Genesis: "granite", Activations: map[string]uint64{"holocene": 0}}).Check())
+
+	// gaps & out of order activations
+	require.Error(t, (&HardforkSchedule{Genesis: "fjord", Activations: map[string]uint64{"holocene": 60}}).Check())
+	require.Error(t, (&HardforkSchedule{Genesis: "granite", Activations: map[string]uint64{"holocene": 120, "interop": 60}}).Check())
+}
+
+func TestHardforkScheduleApply(t *testing.T) {
+	cfg := &genesis.UpgradeScheduleDeployConfig{}
+	(&HardforkSchedule{Genesis: "granite", Activations: map[string]uint64{"holocene": 60}}).apply(cfg)
+	require.NoError(t, cfg.Check(nil))
+
+	require.Equal(t, uint64(0), *cfg.GraniteTime(1000))
+	require.Equal(t, uint64(1060), *cfg.HoloceneTime(1000))
+	require.Nil(t, cfg.InteropTime(1000))
+	require.False(t, cfg.UseInterop)
+	require.Equal(t, genesis.L2AllocsGranite, cfg.AllocMode(1000))
+
+	(&HardforkSchedule{Genesis: "interop"}).apply(cfg)
+	require.Equal(t, uint64(0), *cfg.InteropTime(1000))
+	require.True(t, cfg.UseInterop)
+}
@@ -28,7 +28,7 @@ func createL2PeripheryHost(logger log.Logger, peripheryArtifacts *foundry.Artifa
 
 	l2PeripheryHost := script.NewHost(logger.New("role", "l2", "chain", l2Cfg.L2ChainID), peripheryArtifacts, nil, l2PeripheryContext)
 	l2PeripheryHost.SetEnvVar("OUTPUT_MODE", "none") // we don't use the cheatcode, but capture the state outside of EVM execution
-	l2PeripheryHost.SetEnvVar("FORK", "granite")     // latest fork
+	l2PeripheryHost.SetEnvVar("FORK", string(l2Cfg.AllocMode(genesisTimestamp)))
 
 	return l2PeripheryHost
 }
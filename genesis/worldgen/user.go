@@ -0,0 +1,231 @@
+package worldgen
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/devkeys"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/foundry"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/interopgen"
+	"github.com/ethereum-optimism/optimism/op-chain-ops/script"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// UserGenesis describes the user contracts & balances included in the genesis of every L2
+type UserGenesis struct {
+	// Foundry artifacts of the user project, i.e the `out` directory, can be https:// or a fs path
+	ArtifactsURL *url.URL
+
+	// Script deploying the user contracts, `<file>:<contract>` or `<file>` when
+	// the contract is named after the file. i.e `Deploy.s.sol:Deploy`
+	Script string
+
+	// Path to a JSON of address to account, in the format of a genesis alloc, included in every L2. Or
+	// a JSON of L2 chain id to genesis alloc, included in the L2 of that chain id
+	AllocsPath string
+}
+
+// UserAllocs are the accounts included in the L2 genesis, either shared by every L2 or by L2 chain id
+type UserAllocs struct {
+	Shared  *foundry.ForgeAllocs
+	ByChain map[uint64]*foundry.ForgeAllocs
+}
+
+type DeployUserContractsScript struct {
+	Run func() error
+}
+
+// Check ensures the script & its artifacts are specified together
+func (u *UserGenesis) Check() error {
+	if u.Script != "" && u.ArtifactsURL == nil {
+		return errors.New("user artifacts are required to run the user deploy script")
+	}
+	if u.ArtifactsURL != nil && u.Script == "" {
+		return errors.New("a user deploy script is required with the user artifacts")
+	}
+	if u.Script != "" {
+		if _, _, err := parseScript(u.Script); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LoadUserAllocs loads a genesis alloc, or a JSON of L2 chain id to genesis alloc. Accounts are keyed
+// by hex address and chains by decimal chain id, such that the two formats are told apart by the keys
+func LoadUserAllocs(path string) (*UserAllocs, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read user allocs %q: %w", path, err)
+	}
+
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, fmt.Errorf("failed to decode user allocs %q: %w", path, err)
+	}
+
+	byChain := make(map[uint64]*foundry.ForgeAllocs, len(entries))
+	for key, entry := range entries {
+		chainID, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			byChain = nil
+			break
+		}
+
+		var allocs foundry.ForgeAllocs
+		if err := json.Unmarshal(entry, &allocs); err != nil {
+			return nil, fmt.Errorf("failed to decode user allocs of chain %d: %w", chainID, err)
+		}
+		byChain[chainID] = &allocs
+	}
+	if len(byChain) > 0 {
+		return &UserAllocs{ByChain: byChain}, nil
+	}
+
+	var shared foundry.ForgeAllocs
+	if err := json.Unmarshal(data, &shared); err != nil {
+		return nil, fmt.Errorf("failed to decode user allocs %q: %w", path, err)
+	}
+	return &UserAllocs{Shared: &shared}, nil
+}
+
+// ChainIDs returns the sorted L2 chain ids of per-chain allocs, nil for shared allocs
+func (a *UserAllocs) ChainIDs() []uint64 {
+	if a.ByChain == nil {
+		return nil
+	}
+	chainIDs := make([]uint64, 0, len(a.ByChain))
+	for chainID := range a.ByChain {
+		chainIDs = append(chainIDs, chainID)
+	}
+	slices.Sort(chainIDs)
+	return chainIDs
+}
+
+// For returns the allocs included in the L2 of the chain id, nil if none
+func (a *UserAllocs) For(chainID uint64) *foundry.ForgeAllocs {
+	if a.ByChain != nil {
+		return a.ByChain[chainID]
+	}
+	return a.Shared
+}
+
+// Check ensures per-chain allocs are only specified for generated L2s
+func (a *UserAllocs) Check(l2ChainIDs []uint64) error {
+	for chainID := range a.ByChain {
+		if !slices.Contains(l2ChainIDs, chainID) {
+			return fmt.Errorf("user allocs specified for chain %d which is not generated", chainID)
+		}
+	}
+	return nil
+}
+
+// UserContractsDeployer is the sender of the user deploy script. It is the same account on every
+// L2, and starts from a zero nonce, such that contracts deployed by the script share an address.
+func UserContractsDeployer() (common.Address, error) {
+	hdWallet, err := devkeys.NewMnemonicDevKeys(devkeys.TestMnemonic)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to create hd wallet: %w", err)
+	}
+	return hdWallet.Address(SupersimDevOperatorKey{ChainID: big.NewInt(0), Role: UserContractsDeployerRole})
+}
+
+// parseScript splits `<file>:<contract>` into the script file & contract name
+func parseScript(script string) (string, string, error) {
+	file, contract, found := strings.Cut(script, ":")
+	if !found {
+		contract = strings.TrimSuffix(strings.TrimSuffix(filepath.Base(file), ".sol"), ".s")
+	}
+	if file == "" || contract == "" {
+		return "", "", fmt.Errorf("invalid user deploy script %q, expected <file>:<contract>", script)
+	}
+	return filepath.Base(file), contract, nil
+}
+
+func deployUserContracts(logger log.Logger, l2Host *script.Host, userArtifacts *foundry.ArtifactsFS, scriptName string, l2Cfg *interopgen.L2Config, genesisTimestamp uint64) error {
+	deployer, err := UserContractsDeployer()
+	if err != nil {
+		return fmt.Errorf("failed to derive user contracts deployer: %w", err)
+	}
+
+	// Same as the periphery contracts, the script runs against a fresh L2 state which is imported into the existing L2 state
+	userContext := script.Context{
+		ChainID:      new(big.Int).SetUint64(l2Cfg.L2ChainID),
+		Sender:       deployer,
+		Origin:       deployer,
+		FeeRecipient: common.Address{},
+		GasLimit:     script.DefaultFoundryGasLimit,
+		BlockNum:     uint64(l2Cfg.L2GenesisBlockNumber),
+		Timestamp:    genesisTimestamp,
+	}
+
+	userHost := script.NewHost(logger.New("role", "l2-user", "chain", l2Cfg.L2ChainID), userArtifacts, nil, userContext)
+	if err := userHost.EnableCheats(); err != nil {
+		return fmt.Errorf("failed to enable cheats in L2 state %d: %w", l2Cfg.L2ChainID, err)
+	}
+
+	file, contract, err := parseScript(scriptName)
+	if err != nil {
+		return err
+	}
+
+	deployUserContractsScript, cleanup, err := script.WithScript[DeployUserContractsScript](userHost, file, contract)
+	if err != nil {
+		return fmt.Errorf("failed to load %s script: %w", contract, err)
+	}
+	defer cleanup()
+
+	if err := deployUserContractsScript.Run(); err != nil {
+		return fmt.Errorf("failed to run %s script: %w", contract, err)
+	}
+
+	userStateDump, err := userHost.StateDump()
+	if err != nil {
+		return fmt.Errorf("failed to dump state after deploying user contracts: %w", err)
+	}
+
+	l2Host.ImportState(userStateDump)
+	return nil
+}
+
+// hashLocalArtifacts hashes the content of local artifacts such that a rebuild of the
+// user project invalidates the cached genesis. Remote artifacts are identified by their url.
+func hashLocalArtifacts(artifactsURL *url.URL) ([]byte, error) {
+	if artifactsURL == nil || artifactsURL.Scheme != "file" {
+		return nil, nil
+	}
+
+	hasher := sha256.New()
+	err := filepath.WalkDir(artifactsURL.Path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		f, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		hasher.Write([]byte(strings.TrimPrefix(path, artifactsURL.Path)))
+		_, err = io.Copy(hasher, f)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash user artifacts: %w", err)
+	}
+
+	return hasher.Sum(nil), nil
+}
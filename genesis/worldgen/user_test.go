@@ -0,0 +1,90 @@
+package worldgen
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseScript(t *testing.T) {
+	file, contract, err := parseScript("Deploy.s.sol:DeployProtocol")
+	require.NoError(t, err)
+	require.Equal(t, "Deploy.s.sol", file)
+	require.Equal(t, "DeployProtocol", contract)
+
+	// contract named after the file
+	file, contract, err = parseScript("script/Deploy.s.sol")
+	require.NoError(t, err)
+	require.Equal(t, "Deploy.s.sol", file)
+	require.Equal(t, "Deploy", contract)
+
+	_, _, err = parseScript(":Deploy")
+	require.Error(t, err)
+}
+
+func TestBundleKeyUserGenesis(t *testing.T) {
+	recipe := DefaultRecipe()
+	artifacts := &Artifacts{}
+
+	key, err := bundleKey(recipe, artifacts, nil)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	artifactsDir := filepath.Join(dir, "out")
+	require.NoError(t, os.MkdirAll(artifactsDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(artifactsDir, "Deploy.json"), []byte("{}"), 0o644))
+
+	allocsPath := filepath.Join(dir, "allocs.json")
+	require.NoError(t, os.WriteFile(allocsPath, []byte("{}"), 0o644))
+
+	userGenesis := &UserGenesis{ArtifactsURL: &url.URL{Scheme: "file", Path: artifactsDir}, Script: "Deploy.s.sol", AllocsPath: allocsPath}
+	require.NoError(t, userGenesis.Check())
+
+	userKey, err := bundleKey(recipe, artifacts, userGenesis)
+	require.NoError(t, err)
+	require.NotEqual(t, key, userKey)
+
+	// rebuilt artifacts & modified allocs invalidate the bundle
+	require.NoError(t, os.WriteFile(filepath.Join(artifactsDir, "Deploy.json"), []byte(`{"bytecode":"0x00"}`), 0o644))
+	rebuiltKey, err := bundleKey(recipe, artifacts, userGenesis)
+	require.NoError(t, err)
+	require.NotEqual(t, userKey, rebuiltKey)
+
+	require.NoError(t, os.WriteFile(allocsPath, []byte(`{"0x0000000000000000000000000000000000000001":{"balance":"0x1"}}`), 0o644))
+	modifiedKey, err := bundleKey(recipe, artifacts, userGenesis)
+	require.NoError(t, err)
+	require.NotEqual(t, rebuiltKey, modifiedKey)
+
+	// script without artifacts
+	require.Error(t, (&UserGenesis{Script: "Deploy.s.sol"}).Check())
+}
+
+func TestLoadUserAllocs(t *testing.T) {
+	dir := t.TempDir()
+	account := common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+	sharedPath := filepath.Join(dir, "allocs.json")
+	require.NoError(t, os.WriteFile(sharedPath, []byte(`{"0x0000000000000000000000000000000000000001":{"balance":"0x1"}}`), 0o644))
+	shared, err := LoadUserAllocs(sharedPath)
+	require.NoError(t, err)
+	require.Nil(t, shared.ChainIDs())
+	require.Contains(t, shared.For(10).Accounts, account)
+	require.Contains(t, shared.For(8453).Accounts, account)
+	require.NoError(t, shared.Check([]uint64{10}))
+
+	byChainPath := filepath.Join(dir, "chain-allocs.json")
+	require.NoError(t, os.WriteFile(byChainPath, []byte(`{"8453":{},"10":{"0x0000000000000000000000000000000000000001":{"balance":"0x1"}}}`), 0o644))
+	byChain, err := LoadUserAllocs(byChainPath)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 8453}, byChain.ChainIDs())
+	require.Contains(t, byChain.For(10).Accounts, account)
+	require.Empty(t, byChain.For(8453).Accounts)
+	require.Nil(t, byChain.For(901))
+	require.NoError(t, byChain.Check([]uint64{10, 8453, 901}))
+	require.Error(t, byChain.Check([]uint64{10}))
+}
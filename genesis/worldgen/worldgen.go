@@ -12,13 +12,32 @@ import (
 	"github.com/ethereum-optimism/optimism/op-chain-ops/interopgen"
 )
 
-var defaultRecipe = interopgen.InteropDevRecipe{
-	L1ChainID:        900,
-	L2ChainIDs:       []uint64{901, 902, 903, 904, 905},
-	GenesisTimestamp: uint64(time.Now().Unix()),
+const (
+	DefaultL1ChainID = 900
+)
+
+var DefaultL2ChainIDs = []uint64{901, 902, 903, 904, 905}
+
+// Recipe describes the generated world. It extends the interop dev recipe with the hardfork schedule of the L2s
+type Recipe struct {
+	interopgen.InteropDevRecipe
+
+	// Optional. Every hardfork is active at genesis when not set
+	Hardforks *HardforkSchedule `json:",omitempty"`
 }
 
-func GenerateWorld(ctx context.Context, logger log.Logger, monorepoArtifacts *foundry.ArtifactsFS, peripheryArtifacts *foundry.ArtifactsFS) (*interopgen.WorldDeployment, *interopgen.WorldOutput, error) {
+// DefaultRecipe is the recipe of the genesis files embedded in supersim
+func DefaultRecipe() *Recipe {
+	return &Recipe{
+		InteropDevRecipe: interopgen.InteropDevRecipe{
+			L1ChainID:        DefaultL1ChainID,
+			L2ChainIDs:       DefaultL2ChainIDs,
+			GenesisTimestamp: uint64(time.Now().Unix()),
+		},
+	}
+}
+
+func GenerateWorld(ctx context.Context, logger log.Logger, recipe *Recipe, monorepoArtifacts *foundry.ArtifactsFS, peripheryArtifacts *foundry.ArtifactsFS, userArtifacts *foundry.ArtifactsFS, userGenesis *UserGenesis) (*interopgen.WorldDeployment, *interopgen.WorldOutput, error) {
 	// Initialize dev keys
 	hdWallet, err := devkeys.NewMnemonicDevKeys(devkeys.TestMnemonic)
 	if err != nil {
@@ -26,7 +45,7 @@ func GenerateWorld(ctx context.Context, logger log.Logger, monorepoArtifacts *fo
 	}
 
 	// Build world config
-	cfg, err := defaultRecipe.Build(hdWallet)
+	cfg, err := recipe.Build(hdWallet)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to build world config from recipe: %w", err)
 	}
@@ -39,6 +58,20 @@ func GenerateWorld(ctx context.Context, logger log.Logger, monorepoArtifacts *fo
 		if !cfg.L1.ChainID.IsUint64() || cfg.L1.ChainID.Uint64() != l2Cfg.L1ChainID {
 			return nil, nil, fmt.Errorf("chain L2 %s declared different L1 chain ID %d in config than global %d", id, l2Cfg.L1ChainID, cfg.L1.ChainID)
 		}
+		if recipe.Hardforks != nil {
+			recipe.Hardforks.apply(&l2Cfg.UpgradeScheduleDeployConfig)
+		}
+	}
+
+	var userAllocs *UserAllocs
+	if userGenesis != nil && userGenesis.AllocsPath != "" {
+		userAllocs, err = LoadUserAllocs(userGenesis.AllocsPath)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to load user allocs: %w", err)
+		}
+		if err := userAllocs.Check(recipe.L2ChainIDs); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	deployments := &interopgen.WorldDeployment{
@@ -90,6 +123,7 @@ func GenerateWorld(ctx context.Context, logger log.Logger, monorepoArtifacts *fo
 
 	for l2ChainID, l2Cfg := range cfg.L2s {
 		l2Host := interopgen.CreateL2(logger, monorepoArtifacts, nil, l2Cfg, genesisTimestamp)
+		l2Host.SetEnvVar("FORK", string(l2Cfg.AllocMode(genesisTimestamp))) // predeploys of the hardfork active at genesis
 		if err := l2Host.EnableCheats(); err != nil {
 			return nil, nil, fmt.Errorf("failed to enable cheats in L2 state %s: %w", l2ChainID, err)
 		}
@@ -101,6 +135,17 @@ func GenerateWorld(ctx context.Context, logger log.Logger, monorepoArtifacts *fo
 			return nil, nil, fmt.Errorf("failed to deploy periphery contracts to L2 %s: %w", l2ChainID, err)
 		}
 
+		if userGenesis != nil && userGenesis.Script != "" {
+			if err := deployUserContracts(logger, l2Host, userArtifacts, userGenesis.Script, l2Cfg, genesisTimestamp); err != nil {
+				return nil, nil, fmt.Errorf("failed to deploy user contracts to L2 %s: %w", l2ChainID, err)
+			}
+		}
+		if userAllocs != nil {
+			if allocs := userAllocs.For(l2Cfg.L2ChainID); allocs != nil {
+				l2Host.ImportState(allocs)
+			}
+		}
+
 		l2Out, err := interopgen.CompleteL2(l2Host, l2Cfg, l1GenesisBlock, deployments.L2s[l2ChainID])
 		if err != nil {
 			return nil, nil, fmt.Errorf("failed to complete L2 %s: %w", l2ChainID, err)
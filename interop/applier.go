@@ -89,7 +89,7 @@ func applyAllocForPredeploy(ctx context.Context, chain config.Chain, predeploy p
 	if !ok {
 		return fmt.Errorf("alloc not found %s:", predeploy.impl)
 	}
-	if err := applyAllocToAddress(ctx, chain, &implAlloc, predeploy.impl); err != nil {
+	if err := ApplyAllocToAddress(ctx, chain, &implAlloc, predeploy.impl); err != nil {
 		return fmt.Errorf("failed to apply alloc for %s: %w", predeploy.impl, err)
 	}
 
@@ -97,14 +97,15 @@ func applyAllocForPredeploy(ctx context.Context, chain config.Chain, predeploy p
 	if !ok {
 		return fmt.Errorf("alloc not found %s:", predeploy.proxy)
 	}
-	if err := applyAllocToAddress(ctx, chain, &proxyAlloc, predeploy.proxy); err != nil {
+	if err := ApplyAllocToAddress(ctx, chain, &proxyAlloc, predeploy.proxy); err != nil {
 		return fmt.Errorf("failed to apply alloc for %s: %w", predeploy.proxy, err)
 	}
 
 	return nil
 }
 
-func applyAllocToAddress(ctx context.Context, chain config.Chain, alloc *genesis.Alloc, address common.Address) error {
+// ApplyAllocToAddress sets the code, storage and balance of the genesis alloc onto the address
+func ApplyAllocToAddress(ctx context.Context, chain config.Chain, alloc *genesis.Alloc, address common.Address) error {
 	if alloc.Code != emptyCode {
 		if err := chain.SetCode(ctx, nil, address, alloc.Code); err != nil {
 			return fmt.Errorf("failed to set code for %s: %w", address, err)
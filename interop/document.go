@@ -0,0 +1,58 @@
+package interop
+
+import (
+	"fmt"
+
+	"github.com/ethereum-optimism/supersim/bindings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// L2ToL2MessageDocument is the JSON representation of an indexed message, including the
+// identifier & payload needed to relay it
+type L2ToL2MessageDocument struct {
+	MessageHash common.Hash    `json:"messageHash"`
+	Source      uint64         `json:"source"`
+	Destination uint64         `json:"destination"`
+	Nonce       *hexutil.Big   `json:"nonce"`
+	Sender      common.Address `json:"sender"`
+	Target      common.Address `json:"target"`
+	Message     hexutil.Bytes  `json:"message"`
+
+	Status         string        `json:"status"`
+	SentTxHash     common.Hash   `json:"sentTxHash"`
+	RelayedTxHash  *common.Hash  `json:"relayedTxHash,omitempty"`
+	FailedTxHashes []common.Hash `json:"failedTxHashes,omitempty"`
+
+	Identifier *bindings.ICrossL2InboxIdentifier `json:"identifier"`
+	Payload    hexutil.Bytes                     `json:"payload"`
+}
+
+func (e *L2ToL2MessageStoreEntry) Document() (*L2ToL2MessageDocument, error) {
+	msgHash, err := e.message.Hash()
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate message hash: %w", err)
+	}
+
+	doc := &L2ToL2MessageDocument{
+		MessageHash: msgHash,
+		Source:      e.message.Source,
+		Destination: e.message.Destination,
+		Nonce:       (*hexutil.Big)(e.message.Nonce),
+		Sender:      e.message.Sender,
+		Target:      e.message.Target,
+		Message:     e.message.Message,
+
+		Status:         e.lifecycle.Status().String(),
+		SentTxHash:     e.lifecycle.SentTxHash,
+		FailedTxHashes: e.lifecycle.FailedTxHashes,
+
+		Identifier: e.identifier,
+		Payload:    e.MessagePayload(),
+	}
+	if e.lifecycle.Status() == Relayed {
+		doc.RelayedTxHash = &e.lifecycle.RelayedTxHash
+	}
+	return doc, nil
+}
@@ -2,6 +2,7 @@ package interop
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -112,6 +113,32 @@ func (i *L2ToL2MessageIndexer) Get(msgHash common.Hash) (*L2ToL2MessageStoreEntr
 	return i.storeManager.Get(msgHash)
 }
 
+// GetL2ToL2Message returns the indexed state of the message, nil if the message has not been indexed
+func (i *L2ToL2MessageIndexer) GetL2ToL2Message(ctx context.Context, msgHash common.Hash) (*L2ToL2MessageDocument, error) {
+	entry, err := i.storeManager.Get(msgHash)
+	if errors.Is(err, ErrMessageNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return entry.Document()
+}
+
+// ListL2ToL2Messages returns every indexed message, ordered by source chain and nonce
+func (i *L2ToL2MessageIndexer) ListL2ToL2Messages(ctx context.Context) ([]*L2ToL2MessageDocument, error) {
+	entries := i.storeManager.Entries()
+	docs := make([]*L2ToL2MessageDocument, 0, len(entries))
+	for _, entry := range entries {
+		doc, err := entry.Document()
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	return docs, nil
+}
+
 func (i *L2ToL2MessageIndexer) processEventLog(ctx context.Context, backend ethereum.ChainReader, chainID uint64, log *types.Log) error {
 	relayedMessageEventId := bindings.L2ToL2CrossDomainMessengerParsedABI.Events["RelayedMessage"].ID
 	sentMessageEventId := bindings.L2ToL2CrossDomainMessengerParsedABI.Events["SentMessage"].ID
@@ -119,7 +146,7 @@ func (i *L2ToL2MessageIndexer) processEventLog(ctx context.Context, backend ethe
 
 	switch log.Topics[0] {
 	case sentMessageEventId:
-		identifier, err := getIdentifier(ctx, backend, chainID, log)
+		identifier, err := GetIdentifier(ctx, backend, chainID, log)
 		if err != nil {
 			return fmt.Errorf("failed to get log identifier: %w", err)
 		}
@@ -173,7 +200,8 @@ func (i *L2ToL2MessageIndexer) createSubscription(key string, messageChan chan<-
 	}, nil
 }
 
-func getIdentifier(ctx context.Context, backend ethereum.ChainReader, chainID uint64, log *types.Log) (*bindings.ICrossL2InboxIdentifier, error) {
+// GetIdentifier returns the CrossL2Inbox identifier of a log emitted on the specified chain
+func GetIdentifier(ctx context.Context, backend ethereum.ChainReader, chainID uint64, log *types.Log) (*bindings.ICrossL2InboxIdentifier, error) {
 	blockHeader, err := backend.HeaderByNumber(ctx, big.NewInt(int64(log.BlockNumber)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get block: %w", err)
@@ -160,7 +160,7 @@ func TestGetIdentifier(t *testing.T) {
 
 	mockChainReader := testutils.NewMockChainReader(block)
 
-	identifier, err := getIdentifier(context.Background(), mockChainReader, sourceChainID, &sentMessageLog)
+	identifier, err := GetIdentifier(context.Background(), mockChainReader, sourceChainID, &sentMessageLog)
 	require.NoError(t, err)
 
 	require.Equal(t, identifier.Origin, predeploys.L2toL2CrossDomainMessengerAddr)
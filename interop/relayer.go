@@ -2,6 +2,7 @@ package interop
 
 import (
 	"context"
+	"crypto/ecdsa"
 	"fmt"
 	"math/big"
 
@@ -48,18 +49,9 @@ func (r *L2ToL2MessageRelayer) Start(indexer *L2ToL2MessageIndexer, clients map[
 	r.l2ToL2MessageIndexer = indexer
 	r.clients = clients
 
-	keys, err := devkeys.NewMnemonicDevKeys(devkeys.TestMnemonic)
+	privateKey, err := RelayerPrivateKey()
 	if err != nil {
-		return fmt.Errorf("failed to create dev keys: %w", err)
-	}
-
-	privateKey, err := keys.Secret(devkeys.UserKey(9))
-	// we force the curve to Geth's instance, because Geth does an equality check in the nocgo version:
-	// https://github.com/ethereum/go-ethereum/blob/723b1e36ad6a9e998f06f74cc8b11d51635c6402/crypto/signature_nocgo.go#L82
-	privateKey.PublicKey.Curve = crypto.S256()
-
-	if err != nil {
-		return fmt.Errorf("failed to derive private key: %w", err)
+		return err
 	}
 
 	for destinationChainID, client := range r.clients {
@@ -104,6 +96,24 @@ func (r *L2ToL2MessageRelayer) Start(indexer *L2ToL2MessageIndexer, clients map[
 	return nil
 }
 
+// RelayerPrivateKey returns the key of the dev account relaying messages, 0xa0Ee7A142d267C1f36714E4a8F75612F20a79720
+func RelayerPrivateKey() (*ecdsa.PrivateKey, error) {
+	keys, err := devkeys.NewMnemonicDevKeys(devkeys.TestMnemonic)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dev keys: %w", err)
+	}
+
+	privateKey, err := keys.Secret(devkeys.UserKey(9))
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive private key: %w", err)
+	}
+
+	// we force the curve to Geth's instance, because Geth does an equality check in the nocgo version:
+	// https://github.com/ethereum/go-ethereum/blob/723b1e36ad6a9e998f06f74cc8b11d51635c6402/crypto/signature_nocgo.go#L82
+	privateKey.PublicKey.Curve = crypto.S256()
+	return privateKey, nil
+}
+
 func (r *L2ToL2MessageRelayer) Stop(ctx context.Context) {
 	r.tasksCancel()
 }
@@ -1,7 +1,9 @@
 package interop
 
 import (
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/ethereum-optimism/supersim/bindings"
@@ -17,6 +19,21 @@ const (
 	FailedRelay
 )
 
+var ErrMessageNotFound = errors.New("message not found")
+
+func (s L2ToL2MessageState) String() string {
+	switch s {
+	case Sent:
+		return "sent"
+	case Relayed:
+		return "relayed"
+	case FailedRelay:
+		return "failed"
+	default:
+		return "unknown"
+	}
+}
+
 type L2ToL2MessageLifecycle struct {
 	SentTxHash     common.Hash
 	FailedTxHashes []common.Hash
@@ -102,11 +119,30 @@ func (s *L2ToL2MessageStore) Get(msgHash common.Hash) (*L2ToL2MessageStoreEntry,
 
 	entry, exists := s.entryByHash[msgHash]
 	if !exists {
-		return nil, fmt.Errorf("message not found")
+		return nil, ErrMessageNotFound
 	}
 	return entry, nil
 }
 
+// Entries returns every stored entry, ordered by source chain and nonce
+func (s *L2ToL2MessageStore) Entries() []*L2ToL2MessageStoreEntry {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	entries := make([]*L2ToL2MessageStoreEntry, 0, len(s.entryByHash))
+	for _, entry := range s.entryByHash {
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].message.Source != entries[j].message.Source {
+			return entries[i].message.Source < entries[j].message.Source
+		}
+		return entries[i].message.Nonce.Cmp(entries[j].message.Nonce) < 0
+	})
+	return entries
+}
+
 type UpdaterFunc func(lifecycle *L2ToL2MessageLifecycle) (*L2ToL2MessageLifecycle, error)
 
 func (s *L2ToL2MessageStore) UpdateLifecycle(msgHash common.Hash, updater UpdaterFunc) (*L2ToL2MessageStoreEntry, error) {
@@ -115,7 +151,7 @@ func (s *L2ToL2MessageStore) UpdateLifecycle(msgHash common.Hash, updater Update
 
 	entry, exists := s.entryByHash[msgHash]
 	if !exists {
-		return nil, fmt.Errorf("message not found")
+		return nil, ErrMessageNotFound
 	}
 
 	newLifecycle, err := updater(entry.lifecycle)
@@ -124,8 +160,10 @@ func (s *L2ToL2MessageStore) UpdateLifecycle(msgHash common.Hash, updater Update
 	}
 
 	newEntry := &L2ToL2MessageStoreEntry{
-		message:   entry.message,
-		lifecycle: newLifecycle,
+		message:    entry.message,
+		identifier: entry.identifier,
+		log:        entry.log,
+		lifecycle:  newLifecycle,
 	}
 
 	s.entryByHash[msgHash] = newEntry
@@ -147,6 +185,10 @@ func (s *L2ToL2MessageStoreManager) Get(msgHash common.Hash) (*L2ToL2MessageStor
 	return s.store.Get(msgHash)
 }
 
+func (s *L2ToL2MessageStoreManager) Entries() []*L2ToL2MessageStoreEntry {
+	return s.store.Entries()
+}
+
 func (m *L2ToL2MessageStoreManager) HandleSentEvent(log *types.Log, identifier *bindings.ICrossL2InboxIdentifier) (*L2ToL2MessageStoreEntry, error) {
 	msg, err := NewL2ToL2MessageFromSentMessageEventData(log, identifier)
 	if err != nil {
@@ -4,7 +4,9 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum-optimism/supersim/bindings"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -102,3 +104,76 @@ func TestL2ToL2MessageStore_UpdateLifecycle(t *testing.T) {
 	assert.Equal(t, relayedTxHash, updatedEntry.lifecycle.RelayedTxHash, "expected relayedTxHash to be set in RelayedTxHash")
 	assert.Equal(t, Relayed, updatedEntry.lifecycle.Status(), "expected status to be Relayed")
 }
+
+func TestL2ToL2MessageStoreEntry_Document(t *testing.T) {
+	store := NewL2ToL2MessageStore()
+	msg := &L2ToL2Message{
+		Destination: 1,
+		Source:      2,
+		Nonce:       big.NewInt(1),
+		Sender:      common.HexToAddress("0xSender"),
+		Target:      common.HexToAddress("0xTarget"),
+		Message:     []byte("hello world"),
+	}
+
+	msgHash, err := msg.Hash()
+	assert.NoError(t, err, "expected no error when hashing message")
+
+	identifier := &bindings.ICrossL2InboxIdentifier{ChainId: big.NewInt(2)}
+	entry := &L2ToL2MessageStoreEntry{
+		message:    msg,
+		identifier: identifier,
+		log:        &types.Log{Topics: []common.Hash{common.HexToHash("0x1")}, Data: []byte("data")},
+		lifecycle: &L2ToL2MessageLifecycle{
+			SentTxHash: common.HexToHash("0x1"),
+		},
+	}
+
+	err = store.Set(msgHash, entry)
+	assert.NoError(t, err, "expected no error when setting entry in store")
+
+	_, err = store.Get(common.HexToHash("0x2"))
+	assert.ErrorIs(t, err, ErrMessageNotFound, "expected unknown message to not be found")
+
+	doc, err := entry.Document()
+	assert.NoError(t, err, "expected no error when creating document")
+	assert.Equal(t, msgHash, doc.MessageHash, "expected document hash to be the message hash")
+	assert.Equal(t, "sent", doc.Status, "expected status to be sent")
+	assert.Nil(t, doc.RelayedTxHash, "expected no relayed tx hash")
+
+	// The identifier & payload are retained once relayed
+	relayedTxHash := common.HexToHash("0x3")
+	updatedEntry, err := store.UpdateLifecycle(msgHash, func(lifecycle *L2ToL2MessageLifecycle) (*L2ToL2MessageLifecycle, error) {
+		return lifecycle.WithRelayedTxHash(relayedTxHash), nil
+	})
+	assert.NoError(t, err, "expected no error when updating lifecycle")
+
+	doc, err = updatedEntry.Document()
+	assert.NoError(t, err, "expected no error when creating document")
+	assert.Equal(t, "relayed", doc.Status, "expected status to be relayed")
+	assert.Equal(t, relayedTxHash, *doc.RelayedTxHash, "expected relayed tx hash to be set")
+	assert.Equal(t, identifier, doc.Identifier, "expected identifier to be retained")
+	assert.Equal(t, entry.MessagePayload(), []byte(doc.Payload), "expected payload to be retained")
+}
+
+func TestL2ToL2MessageStore_Entries(t *testing.T) {
+	store := NewL2ToL2MessageStore()
+	for _, msg := range []*L2ToL2Message{
+		{Destination: 1, Source: 3, Nonce: big.NewInt(0)},
+		{Destination: 1, Source: 2, Nonce: big.NewInt(1)},
+		{Destination: 1, Source: 2, Nonce: big.NewInt(0)},
+	} {
+		msgHash, err := msg.Hash()
+		assert.NoError(t, err, "expected no error when hashing message")
+
+		err = store.Set(msgHash, &L2ToL2MessageStoreEntry{message: msg, lifecycle: &L2ToL2MessageLifecycle{}})
+		assert.NoError(t, err, "expected no error when setting entry in store")
+	}
+
+	entries := store.Entries()
+	assert.Len(t, entries, 3, "expected every entry to be returned")
+	assert.Equal(t, uint64(2), entries[0].Message().Source, "expected entries to be ordered by source")
+	assert.Equal(t, int64(0), entries[0].Message().Nonce.Int64(), "expected entries to be ordered by nonce")
+	assert.Equal(t, int64(1), entries[1].Message().Nonce.Int64(), "expected entries to be ordered by nonce")
+	assert.Equal(t, uint64(3), entries[2].Message().Source, "expected entries to be ordered by source")
+}
@@ -0,0 +1,222 @@
+package logfollower
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// Time between attempts to re-establish a dropped log subscription
+	resubscribeInterval = time.Second
+
+	// Time between attempts to process a log that failed to process
+	retryInterval = time.Second
+
+	// Attempts to process a log before giving up on it, such that it does not hold back later logs forever
+	maxAttempts = 10
+)
+
+// ErrRetry is wrapped by the errors of logs that failed to process and should be retried
+var ErrRetry = errors.New("retry")
+
+// Backend subscribes to the logs of a chain and backfills the logs the subscription missed
+type Backend interface {
+	ethereum.LogFilterer
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+type logID struct {
+	blockHash common.Hash
+	index     uint
+}
+
+// Follower processes the logs matching a query that are emitted since the starting block. The logs since the last
+// processed block are backfilled when subscribing, on start and whenever the subscription drops, such that no log
+// is lost, and each log is processed once. Logs are processed in order: a log failing with ErrRetry holds back later
+// logs until processed or failing `maxAttempts` times, other failures are logged and skipped. The follower can be
+// rewound to a block once its chain is reset, logs before that block are then ignored
+type Follower struct {
+	log     log.Logger
+	backend Backend
+	query   ethereum.FilterQuery
+	process func(context.Context, *types.Log) error
+
+	// Guards the cursor, processed logs & attempts, held while backfilling or processing a log such that a
+	// rewind does not interleave with either
+	mu sync.Mutex
+
+	// Logs before the starting block are ignored, such as the logs of a forked block
+	startBlock uint64
+
+	// Last processed block, backfilled again as its logs may have been partially processed. The cursor
+	// is held at the first block with a log to retry, backfilled again until processed
+	cursor    uint64
+	held      bool
+	processed map[logID]struct{}
+
+	// Failed attempts of the held log
+	attempts    int
+	maxAttempts int
+}
+
+// New follows the logs of the query, ignoring its block range, from the starting block
+func New(log log.Logger, backend Backend, query ethereum.FilterQuery, startBlock uint64, process func(context.Context, *types.Log) error) *Follower {
+	return &Follower{
+		log:     log,
+		backend: backend,
+		query:   query,
+		process: process,
+
+		startBlock: startBlock,
+		cursor:     startBlock,
+		processed:  make(map[logID]struct{}),
+
+		maxAttempts: maxAttempts,
+	}
+}
+
+// Rewind follows the logs from the block onwards, forgetting the processed logs. Used once the chain is reset,
+// such that the logs of the blocks before the reset are not processed
+func (f *Follower) Rewind(block uint64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.startBlock, f.cursor, f.held = block, block, false
+	f.attempts = 0
+	clear(f.processed)
+}
+
+// Run processes logs until the context is cancelled, re-subscribing whenever the subscription drops
+func (f *Follower) Run(ctx context.Context) error {
+	for {
+		err := f.follow(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		f.log.Warn("log subscription dropped, resubscribing", "err", err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(resubscribeInterval):
+		}
+	}
+}
+
+// follow backfills the logs since the cursor and processes the logs of the subscription until it fails
+func (f *Follower) follow(ctx context.Context) error {
+	logCh := make(chan types.Log)
+	sub, err := f.backend.SubscribeFilterLogs(ctx, f.filterQuery(nil, nil), logCh)
+	if err != nil {
+		return fmt.Errorf("failed to create log subscription: %w", err)
+	}
+	defer sub.Unsubscribe()
+
+	// Backfilled once subscribed such that no log is emitted in between. Logs seen twice are skipped
+	if err := f.backfill(ctx); err != nil {
+		return err
+	}
+
+	retry := time.NewTicker(retryInterval)
+	defer retry.Stop()
+
+	for {
+		select {
+		case log := <-logCh:
+			f.mu.Lock()
+			f.processLog(ctx, &log)
+			f.mu.Unlock()
+		case <-retry.C:
+			f.mu.Lock()
+			held := f.held
+			f.mu.Unlock()
+			if held {
+				if err := f.backfill(ctx); err != nil {
+					return err
+				}
+			}
+		case err := <-sub.Err():
+			return fmt.Errorf("log subscription error: %w", err)
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (f *Follower) backfill(ctx context.Context) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	head, err := f.backend.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the latest block: %w", err)
+	}
+
+	// The chain is behind the cursor once reverted
+	from := min(f.cursor, head)
+
+	logs, err := f.backend.FilterLogs(ctx, f.filterQuery(new(big.Int).SetUint64(from), new(big.Int).SetUint64(head)))
+	if err != nil {
+		return fmt.Errorf("failed to backfill logs from block %d to %d: %w", from, head, err)
+	}
+
+	if len(logs) > 0 {
+		f.log.Debug("backfilling logs", "from", from, "to", head, "logs", len(logs))
+	}
+
+	f.cursor, f.held = from, false
+	for i := range logs {
+		f.processLog(ctx, &logs[i])
+	}
+	if !f.held {
+		f.cursor = head
+	}
+	return nil
+}
+
+// processLog processes the log, the caller holding the lock
+func (f *Follower) processLog(ctx context.Context, log *types.Log) {
+	// Later logs are processed once the held log is, by the next backfill
+	if log.Removed || f.held || log.BlockNumber < f.startBlock {
+		return
+	}
+
+	id := logID{log.BlockHash, log.Index}
+	if _, ok := f.processed[id]; ok {
+		f.cursor = max(f.cursor, log.BlockNumber)
+		return
+	}
+
+	if err := f.process(ctx, log); err != nil {
+		if errors.Is(err, ErrRetry) {
+			f.attempts++
+			if f.attempts < f.maxAttempts {
+				f.log.Warn("failed to process log, retrying", "txHash", log.TxHash, "logIndex", log.Index, "attempt", f.attempts, "err", err)
+				f.cursor, f.held = log.BlockNumber, true
+				return
+			}
+			f.log.Error("failed to process log, giving up", "txHash", log.TxHash, "logIndex", log.Index, "attempts", f.attempts, "err", err)
+		} else {
+			f.log.Error("failed to process log", "txHash", log.TxHash, "logIndex", log.Index, "err", err)
+		}
+	}
+
+	f.processed[id] = struct{}{}
+	f.attempts = 0
+	f.cursor = max(f.cursor, log.BlockNumber)
+}
+
+func (f *Follower) filterQuery(from, to *big.Int) ethereum.FilterQuery {
+	query := f.query
+	query.FromBlock, query.ToBlock = from, to
+	return query
+}
@@ -0,0 +1,230 @@
+package logfollower
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+
+	"github.com/stretchr/testify/require"
+)
+
+// createMockLogs returns a log in each block from 1
+func createMockLogs(num int) []types.Log {
+	out := make([]types.Log, num)
+	for i := range num {
+		out[i] = types.Log{BlockNumber: uint64(i + 1), BlockHash: common.BigToHash(big.NewInt(int64(i + 1))), Index: uint(i)}
+	}
+	return out
+}
+
+type mockSubscription struct {
+	errCh chan error
+}
+
+func (s *mockSubscription) Unsubscribe() {}
+
+func (s *mockSubscription) Err() <-chan error {
+	return s.errCh
+}
+
+// mockLogBackend serves the logs of the chain up to its head. Each subscription streams its logs and then
+// drops when it has an error, subscriptions past the scripted ones stream nothing
+type mockLogBackend struct {
+	mu            sync.Mutex
+	logs          []types.Log
+	head          uint64
+	subscriptions []mockSubscriptionScript
+}
+
+type mockSubscriptionScript struct {
+	logs []types.Log
+	err  error
+}
+
+func (b *mockLogBackend) BlockNumber(ctx context.Context) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.head, nil
+}
+
+func (b *mockLogBackend) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var logs []types.Log
+	for _, log := range b.logs {
+		if log.BlockNumber >= q.FromBlock.Uint64() && log.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func (b *mockLogBackend) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &mockSubscription{errCh: make(chan error, 1)}
+	if len(b.subscriptions) == 0 {
+		return sub, nil
+	}
+
+	script := b.subscriptions[0]
+	b.subscriptions = b.subscriptions[1:]
+	go func() {
+		for _, log := range script.logs {
+			select {
+			case ch <- log:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if script.err != nil {
+			sub.errCh <- script.err
+		}
+	}()
+	return sub, nil
+}
+
+// runFollower runs the follower until the expected number of logs are processed, returning them in order
+func runFollower(t *testing.T, backend *mockLogBackend, startBlock uint64, num int, process func(*types.Log) error) []types.Log {
+	return runFollowerWithAttempts(t, backend, startBlock, num, maxAttempts, process)
+}
+
+func runFollowerWithAttempts(t *testing.T, backend *mockLogBackend, startBlock uint64, num, attempts int, process func(*types.Log) error) []types.Log {
+	var mu sync.Mutex
+	var processed []types.Log
+
+	follower := New(testlog.Logger(t, log.LevelInfo), backend, ethereum.FilterQuery{}, startBlock, func(_ context.Context, log *types.Log) error {
+		if err := process(log); err != nil {
+			return err
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		processed = append(processed, *log)
+		return nil
+	})
+	follower.maxAttempts = attempts
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		require.NoError(t, follower.Run(ctx))
+		close(done)
+	}()
+
+	require.Eventually(t, func() bool {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(processed) >= num
+	}, 10*time.Second, 10*time.Millisecond)
+
+	cancel()
+	<-done
+
+	mu.Lock()
+	defer mu.Unlock()
+	return processed
+}
+
+func TestFollowerBackfillsMissedLogs(t *testing.T) {
+	logs := createMockLogs(4)
+
+	// Logs 1-2 emitted before start, the first subscription streams log 2 again before
+	// dropping and log 3 is emitted while resubscribing. Log 4 is streamed live
+	backend := &mockLogBackend{
+		logs: logs[:3],
+		head: 3,
+		subscriptions: []mockSubscriptionScript{
+			{logs: logs[1:2], err: errors.New("websocket closed")},
+			{logs: logs[3:]},
+		},
+	}
+
+	processed := runFollower(t, backend, 0, 4, func(*types.Log) error { return nil })
+	require.Equal(t, logs, processed)
+}
+
+func TestFollowerRetriesFailedLogs(t *testing.T) {
+	logs := createMockLogs(3)
+	backend := &mockLogBackend{logs: logs, head: 3}
+
+	// The first attempt of log 2 fails, holding back log 3 until retried
+	failed := false
+	processed := runFollower(t, backend, 1, 3, func(log *types.Log) error {
+		if log.BlockNumber == 2 && !failed {
+			failed = true
+			return ErrRetry
+		}
+		return nil
+	})
+	require.Equal(t, logs, processed)
+}
+
+func TestFollowerSkipsFailedLogs(t *testing.T) {
+	logs := createMockLogs(3)
+	backend := &mockLogBackend{logs: logs, head: 3}
+
+	// Logs failing for good are skipped rather than holding back later logs
+	processed := runFollower(t, backend, 0, 2, func(log *types.Log) error {
+		if log.BlockNumber == 2 {
+			return errors.New("malformed")
+		}
+		return nil
+	})
+	require.Equal(t, []types.Log{logs[0], logs[2]}, processed)
+}
+
+func TestFollowerGivesUpOnFailingLogs(t *testing.T) {
+	logs := createMockLogs(3)
+	backend := &mockLogBackend{logs: logs, head: 3}
+
+	// Log 2 keeps failing, it is given up on such that log 3 is processed
+	var attempts int
+	processed := runFollowerWithAttempts(t, backend, 0, 2, 2, func(log *types.Log) error {
+		if log.BlockNumber == 2 {
+			attempts++
+			return ErrRetry
+		}
+		return nil
+	})
+	require.Equal(t, []types.Log{logs[0], logs[2]}, processed)
+	require.Equal(t, 2, attempts)
+}
+
+func TestFollowerRewind(t *testing.T) {
+	logs := createMockLogs(3)
+	backend := &mockLogBackend{logs: logs, head: 3}
+
+	var processed []types.Log
+	follower := New(testlog.Logger(t, log.LevelInfo), backend, ethereum.FilterQuery{}, 0, func(_ context.Context, log *types.Log) error {
+		processed = append(processed, *log)
+		return nil
+	})
+	require.NoError(t, follower.backfill(context.Background()))
+	require.Equal(t, logs, processed)
+
+	// The chain is re-forked at block 4 of another history. The logs up to the fork are not processed
+	resetLogs := createMockLogs(6)[1:]
+	for i := range resetLogs {
+		resetLogs[i].BlockHash = common.BigToHash(big.NewInt(int64(100 + i)))
+	}
+	backend.mu.Lock()
+	backend.logs, backend.head = resetLogs, 6
+	backend.mu.Unlock()
+
+	processed = nil
+	follower.Rewind(5)
+	require.NoError(t, follower.backfill(context.Background()))
+	require.Equal(t, resetLogs[3:], processed)
+}
@@ -7,71 +7,57 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
 
+	"github.com/ethereum-optimism/supersim/logfollower"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/ethereum/go-ethereum"
 )
 
-var _ ethereum.Subscription = &depositTxSubscription{}
-
-type depositTxSubscription struct {
-	logSubscription ethereum.Subscription
-	logCh           chan types.Log
-	errCh           chan error
-	doneCh          chan struct{}
+// DepositRelayer relays the deposits of an OptimismPortal to its L2. Deposits are followed from the starting L1
+// block such that no deposit is lost, see logfollower.Follower. Deposits are relayed once and in order: a deposit
+// that fails to relay holds back later deposits until retried successfully
+type DepositRelayer struct {
+	log      log.Logger
+	send     func(context.Context, *types.Transaction) error
+	follower *logfollower.Follower
 }
 
-func (d *depositTxSubscription) Unsubscribe() {
-	d.logSubscription.Unsubscribe()
-	d.doneCh <- struct{}{}
+// NewDepositRelayer relays the deposits emitted from the starting L1 block through `send`
+func NewDepositRelayer(log log.Logger, backend logfollower.Backend, portal common.Address, startBlock uint64, send func(context.Context, *types.Transaction) error) *DepositRelayer {
+	r := &DepositRelayer{log: log, send: send}
+
+	query := ethereum.FilterQuery{Addresses: []common.Address{portal}, Topics: [][]common.Hash{{derive.DepositEventABIHash}}}
+	r.follower = logfollower.New(log, backend, query, startBlock, r.relay)
+	return r
 }
 
-func (d *depositTxSubscription) Err() <-chan error {
-	return d.errCh
+// Rewind relays the deposits from the L1 block onwards, once the L1 is reset
+func (r *DepositRelayer) Rewind(block uint64) {
+	r.follower.Rewind(block)
 }
 
-type LogSubscriber interface {
-	SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error)
+// Run relays deposits until the context is cancelled
+func (r *DepositRelayer) Run(ctx context.Context) error {
+	return r.follower.Run(ctx)
 }
 
-// transforms Deposit event logs into DepositTx
-func SubscribeDepositTx(ctx context.Context, logSub LogSubscriber, depositContractAddr common.Address, ch chan<- *types.DepositTx) (ethereum.Subscription, error) {
-	logCh := make(chan types.Log)
-	filterQuery := ethereum.FilterQuery{Addresses: []common.Address{depositContractAddr}, Topics: [][]common.Hash{{derive.DepositEventABIHash}}}
-	logSubscription, err := logSub.SubscribeFilterLogs(ctx, filterQuery, logCh)
+func (r *DepositRelayer) relay(ctx context.Context, log *types.Log) error {
+	dep, err := logToDepositTx(log)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create log subscription: %w", err)
+		return fmt.Errorf("failed to decode deposit event: %w", err)
 	}
 
-	errCh := make(chan error)
-	doneCh := make(chan struct{})
-	logErrCh := logSubscription.Err()
-
-	go func() {
-		defer close(logCh)
-		defer close(errCh)
-		defer close(doneCh)
-		for {
-			select {
-			case log := <-logCh:
-				dep, err := logToDepositTx(&log)
-				if err != nil {
-					errCh <- err
-					continue
-				}
-				ch <- dep
-			case err := <-logErrCh:
-				errCh <- fmt.Errorf("log subscription error: %w", err)
-			case <-ctx.Done():
-				return
-			case <-doneCh:
-				return
-			}
-		}
-	}()
+	depTx := types.NewTx(dep)
+	r.log.Debug("observed deposit event on L1", "hash", depTx.Hash().String())
+	if err := r.send(ctx, depTx); err != nil {
+		return fmt.Errorf("%w: failed to submit deposit tx %s to chain: %w", logfollower.ErrRetry, depTx.Hash(), err)
+	}
 
-	return &depositTxSubscription{logSubscription, logCh, errCh, doneCh}, nil
+	r.log.Info("OptimismPortal#depositTransaction", "l2TxHash", depTx.Hash().String())
+	return nil
 }
 
 func logToDepositTx(log *types.Log) (*types.DepositTx, error) {
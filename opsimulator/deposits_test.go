@@ -2,87 +2,235 @@ package opsimulator
 
 import (
 	"context"
+	"errors"
+	"math/big"
 	"math/rand"
+	"sync"
+	"time"
 
 	"testing"
 
 	"github.com/ethereum-optimism/optimism/op-node/rollup/derive"
+	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	optestutils "github.com/ethereum-optimism/optimism/op-service/testutils"
 
-	"github.com/ethereum-optimism/supersim/config"
-	"github.com/ethereum-optimism/supersim/testutils"
-
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/stretchr/testify/require"
 )
 
-var _ config.Chain = &MockChainWithSubscriptions{}
+var mockPortalAddress = common.HexToAddress("0xdeadbeefdeadbeefdeadbeefdeadbeef00000000")
 
-func createMockDepositTxs() []*types.DepositTx {
-	num := 10
-	out := make([]*types.DepositTx, num)
+// createMockDepositLogs returns a deposit log in each block from 1
+func createMockDepositLogs(t *testing.T, num int) []types.Log {
+	out := make([]types.Log, num)
 	for i := range num {
 		rng := rand.New(rand.NewSource(int64(i)))
+		dep := optestutils.GenerateDeposit(common.Hash{}, rng)
 
-		source := derive.UserDepositSource{
-			L1BlockHash: optestutils.RandomHash(rng),
-			LogIndex:    uint64(rng.Intn(10000)),
-		}
-		sourceHash := source.SourceHash()
-		depInput := optestutils.GenerateDeposit(sourceHash, rng)
-		out[i] = depInput
+		log, err := derive.MarshalDepositLogEvent(mockPortalAddress, dep)
+		require.NoError(t, err)
+
+		log.BlockNumber = uint64(i + 1)
+		log.BlockHash = common.BigToHash(big.NewInt(int64(i + 1)))
+		out[i] = *log
 	}
 	return out
 }
 
-type MockChainWithSubscriptions struct {
-	*testutils.MockChain
-	mockDepositTxs []*types.DepositTx
+type mockSubscription struct {
+	errCh chan error
 }
 
-func (c *MockChainWithSubscriptions) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
-	go func() {
-		for _, dep := range c.mockDepositTxs {
-			log, _ := derive.MarshalDepositLogEvent(common.HexToAddress("0xdeadbeefdeadbeefdeadbeefdeadbeef00000000"), dep)
-			ch <- *log
+func (s *mockSubscription) Unsubscribe() {}
+
+func (s *mockSubscription) Err() <-chan error {
+	return s.errCh
+}
+
+// mockDepositBackend serves the logs of the chain up to its head. Each subscription streams its logs and then
+// drops when it has an error, subscriptions past the scripted ones stream nothing
+type mockDepositBackend struct {
+	mu            sync.Mutex
+	logs          []types.Log
+	head          uint64
+	subscriptions []mockSubscriptionScript
+	filterQueries []ethereum.FilterQuery
+}
 
+type mockSubscriptionScript struct {
+	logs []types.Log
+	err  error
+}
+
+func (b *mockDepositBackend) BlockNumber(ctx context.Context) (uint64, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.head, nil
+}
+
+func (b *mockDepositBackend) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.filterQueries = append(b.filterQueries, q)
+
+	var logs []types.Log
+	for _, log := range b.logs {
+		if log.BlockNumber >= q.FromBlock.Uint64() && log.BlockNumber <= q.ToBlock.Uint64() {
+			logs = append(logs, log)
+		}
+	}
+	return logs, nil
+}
+
+func (b *mockDepositBackend) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	sub := &mockSubscription{errCh: make(chan error, 1)}
+	if len(b.subscriptions) == 0 {
+		return sub, nil
+	}
+
+	script := b.subscriptions[0]
+	b.subscriptions = b.subscriptions[1:]
+	go func() {
+		for _, log := range script.logs {
+			select {
+			case ch <- log:
+			case <-ctx.Done():
+				return
+			}
+		}
+		if script.err != nil {
+			sub.errCh <- script.err
 		}
 	}()
+	return sub, nil
+}
+
+func (b *mockDepositBackend) queries() []ethereum.FilterQuery {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]ethereum.FilterQuery{}, b.filterQueries...)
+}
+
+type mockDepositSender struct {
+	mu   sync.Mutex
+	txs  []*types.Transaction
+	fail map[common.Hash]int
+}
 
-	return &testutils.MockSubscription{}, nil
+func (s *mockDepositSender) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.fail[tx.SourceHash()] > 0 {
+		s.fail[tx.SourceHash()]--
+		return errors.New("failed to send")
+	}
+	s.txs = append(s.txs, tx)
+	return nil
+}
+
+func (s *mockDepositSender) sent() []*types.Transaction {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*types.Transaction{}, s.txs...)
 }
 
-func TestSubscribeDepositTx(t *testing.T) {
-	mockDepositTxs := createMockDepositTxs()
-	chain := MockChainWithSubscriptions{testutils.NewMockChain(), mockDepositTxs}
+func runDepositRelayer(t *testing.T, backend *mockDepositBackend, sender *mockDepositSender, startBlock uint64) {
+	ctx, cancel := context.WithCancel(context.Background())
+	relayer := NewDepositRelayer(testlog.Logger(t, log.LevelInfo), backend, mockPortalAddress, startBlock, sender.SendTransaction)
 
-	ctx := context.Background()
+	done := make(chan error)
+	go func() { done <- relayer.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		require.NoError(t, <-done)
+	})
+}
 
-	depositTxCh := make(chan *types.DepositTx, len(mockDepositTxs))
+func requireRelayedOnce(t *testing.T, sender *mockDepositSender, logs []types.Log) {
+	require.Eventually(t, func() bool { return len(sender.sent()) >= len(logs) }, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
 
-	sub, err := SubscribeDepositTx(ctx, &chain, common.HexToAddress(""), depositTxCh)
-	if err != nil {
+	sent := sender.sent()
+	require.Len(t, sent, len(logs), "expected every deposit to be relayed once")
+	for i := range logs {
+		dep, err := logToDepositTx(&logs[i])
 		require.NoError(t, err)
+		require.Equal(t, types.NewTx(dep).Hash(), sent[i].Hash())
+		require.Equal(t, dep.SourceHash, sent[i].SourceHash())
+		require.Equal(t, dep.Mint, sent[i].Mint())
+		require.Equal(t, dep.Data, sent[i].Data())
 	}
+}
 
-	for i := 0; i < len(mockDepositTxs); i++ {
-		dep := <-depositTxCh
+func TestDepositRelayerBackfillsAndDeduplicates(t *testing.T) {
+	logs := createMockDepositLogs(t, 10)
 
-		// Source hash is lost in the marshal process
-		require.Equal(t, dep.From, mockDepositTxs[i].From)
-		require.Equal(t, dep.To, mockDepositTxs[i].To)
-		require.Equal(t, dep.Mint, mockDepositTxs[i].Mint)
-		require.Equal(t, dep.Value, mockDepositTxs[i].Value)
-		require.Equal(t, dep.Gas, mockDepositTxs[i].Gas)
-		require.Equal(t, dep.IsSystemTransaction, mockDepositTxs[i].IsSystemTransaction)
-		require.Equal(t, dep.Data, mockDepositTxs[i].Data)
+	// Deposits before the subscription are backfilled, the subscription replays some of them
+	backend := &mockDepositBackend{logs: logs[:6], head: 6, subscriptions: []mockSubscriptionScript{{logs: logs[4:]}}}
+	sender := &mockDepositSender{}
+	runDepositRelayer(t, backend, sender, 0)
 
+	requireRelayedOnce(t, sender, logs)
+
+	queries := backend.queries()
+	require.Len(t, queries, 1)
+	require.Equal(t, uint64(0), queries[0].FromBlock.Uint64())
+	require.Equal(t, uint64(6), queries[0].ToBlock.Uint64())
+	require.Equal(t, []common.Address{mockPortalAddress}, queries[0].Addresses)
+}
+
+func TestDepositRelayerResubscribesAndBackfills(t *testing.T) {
+	logs := createMockDepositLogs(t, 8)
+
+	// Deposits 5 through 7 are emitted while the subscription is dropped
+	backend := &mockDepositBackend{
+		logs:          logs[:2],
+		head:          2,
+		subscriptions: []mockSubscriptionScript{{logs: logs[:4], err: errors.New("websocket closed")}},
 	}
 
-	sub.Unsubscribe()
+	sender := &mockDepositSender{}
+	runDepositRelayer(t, backend, sender, 0)
+
+	require.Eventually(t, func() bool { return len(sender.sent()) == 4 }, 5*time.Second, 10*time.Millisecond)
+	backend.mu.Lock()
+	backend.logs, backend.head = logs, 8
+	backend.mu.Unlock()
+
+	requireRelayedOnce(t, sender, logs)
+
+	// The backfill after reconnecting resumes from the last processed block
+	queries := backend.queries()
+	require.Len(t, queries, 2)
+	require.Equal(t, uint64(4), queries[1].FromBlock.Uint64())
+	require.Equal(t, uint64(8), queries[1].ToBlock.Uint64())
+}
+
+func TestDepositRelayerRetriesFailedDeposits(t *testing.T) {
+	logs := createMockDepositLogs(t, 4)
+	failed, err := logToDepositTx(&logs[1])
+	require.NoError(t, err)
+
+	// The subscription stays healthy, the failed deposit is retried without reconnecting
+	backend := &mockDepositBackend{logs: logs, head: 4}
+	sender := &mockDepositSender{fail: map[common.Hash]int{failed.SourceHash: 1}}
+	runDepositRelayer(t, backend, sender, 1)
+
+	// Later deposits are held back such that every deposit is relayed in order
+	requireRelayedOnce(t, sender, logs)
 
-	close(depositTxCh)
+	// Relayed once the backfill resumes from the block of the failed deposit
+	queries := backend.queries()
+	require.Len(t, queries, 2)
+	require.Equal(t, uint64(1), queries[0].FromBlock.Uint64())
+	require.Equal(t, uint64(2), queries[1].FromBlock.Uint64())
 }
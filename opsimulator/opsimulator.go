@@ -12,8 +12,10 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 
+	opbindings "github.com/ethereum-optimism/optimism/op-e2e/bindings"
 	ophttp "github.com/ethereum-optimism/optimism/op-service/httputil"
 	"github.com/ethereum-optimism/optimism/op-service/predeploys"
 	"github.com/ethereum-optimism/optimism/op-service/tasks"
@@ -32,9 +34,13 @@ import (
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+var _ config.Chain = &OpSimulator{}
+
 const (
-	host                        = "127.0.0.1"
-	l2NativeSuperchainERC20Addr = "0x420beeF000000000000000000000000000000001"
+	host = "127.0.0.1"
+
+	// Test token predeployed on every L2
+	L2NativeSuperchainERC20Addr = "0x420beeF000000000000000000000000000000001"
 )
 
 type OpSimulator struct {
@@ -56,6 +62,24 @@ type OpSimulator struct {
 
 	ethClient *ethclient.Client
 
+	depositRelayer *DepositRelayer
+
+	// SuperchainERC20s whose crosschain mints & burns are logged
+	watchedTokensMu sync.Mutex
+	watchedTokens   map[common.Address]bool
+
+	// Raw transactions sent through the simulator, replayable after a re-fork
+	sentTxsMu sync.Mutex
+	sentTxs   []hexutil.Bytes
+
+	// User transactions are queued or rejected while the sequencer is paused
+	sequencerMu       sync.Mutex
+	sequencerPaused   bool
+	sequencerMode     SequencerMode
+	sequencerPausedAt uint64
+	sequencingWindow  uint64
+	queuedTxs         []hexutil.Bytes
+
 	stopped atomic.Bool
 }
 
@@ -131,76 +155,79 @@ func (opSim *OpSimulator) Stop(ctx context.Context) error {
 	return opSim.httpServer.Stop(ctx)
 }
 
+// RewindDeposits relays the deposits from the L1 block onwards, once the L1 is re-forked
+func (opSim *OpSimulator) RewindDeposits(l1Block uint64) {
+	opSim.depositRelayer.Rewind(l1Block)
+}
+
 func (opSim *OpSimulator) EthClient() *ethclient.Client {
 	return opSim.ethClient
 }
 
 func (opSim *OpSimulator) startBackgroundTasks() {
-	// Relay deposit tx from L1 to L2
+	// Relay deposit tx from L1 to L2, from the genesis of a local L1 or the block after the fork
+	var startBlock uint64
+	if forkConfig := opSim.l1Chain.Config().ForkConfig; forkConfig != nil {
+		startBlock = forkConfig.BlockNumber + 1
+	}
+	portalAddress := common.Address(opSim.Config().L2Config.L1Addresses.OptimismPortalProxy)
+	opSim.depositRelayer = NewDepositRelayer(opSim.log, opSim.l1Chain.EthClient(), portalAddress, startBlock, opSim.Chain.EthClient().SendTransaction)
 	opSim.bgTasks.Go(func() error {
-		depositTxCh := make(chan *types.DepositTx)
-		portalAddress := common.Address(opSim.Config().L2Config.L1Addresses.OptimismPortalProxy)
-		sub, err := SubscribeDepositTx(context.Background(), opSim.l1Chain.EthClient(), portalAddress, depositTxCh)
+		return opSim.depositRelayer.Run(opSim.bgTasksCtx)
+	})
+
+	// Log ERC20 deposits through the L1StandardBridge of this chain
+	opSim.bgTasks.Go(func() error {
+		bridgeAddress := common.Address(opSim.Config().L2Config.L1Addresses.L1StandardBridgeProxy)
+		l1StandardBridge, err := opbindings.NewL1StandardBridge(bridgeAddress, opSim.l1Chain.EthClient())
 		if err != nil {
-			return fmt.Errorf("failed to subscribe to deposit tx: %w", err)
+			return fmt.Errorf("failed to create L1StandardBridge contract: %w", err)
 		}
 
-		chainId := opSim.Config().ChainID
+		depositEventChan := make(chan *opbindings.L1StandardBridgeERC20DepositInitiated)
+		depositSub, err := l1StandardBridge.WatchERC20DepositInitiated(&bind.WatchOpts{Context: opSim.bgTasksCtx}, depositEventChan, nil, nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to L1StandardBridge#ERC20DepositInitiated: %w", err)
+		}
 
 		for {
 			select {
-			case dep := <-depositTxCh:
-				depTx := types.NewTx(dep)
-				opSim.log.Debug("observed deposit event on L1", "hash", depTx.Hash().String())
-
-				clnt := opSim.Chain.EthClient()
-				if err := clnt.SendTransaction(opSim.bgTasksCtx, depTx); err != nil {
-					opSim.log.Error("failed to submit deposit tx to chain: %w", "chain.id", chainId, "err", err)
-				}
-
-				opSim.log.Info("OptimismPortal#depositTransaction", "l2TxHash", depTx.Hash().String())
-
+			case event := <-depositEventChan:
+				opSim.log.Info("L1StandardBridge#ERC20DepositInitiated", "l1Token", event.L1Token, "l2Token", event.L2Token, "from", event.From, "to", event.To, "amount", event.Amount, "l1TxHash", event.Raw.TxHash)
 			case <-opSim.bgTasksCtx.Done():
-				sub.Unsubscribe()
-				close(depositTxCh)
+				depositSub.Unsubscribe()
 				return nil
 			}
 		}
 	})
 
-	// Log L2NativeSuperchainERC20 events
+	// Log L2StandardBridge deposits
 	opSim.bgTasks.Go(func() error {
-		superchainERC20, err := bindings.NewL2NativeSuperchainERC20(common.HexToAddress(l2NativeSuperchainERC20Addr), opSim.Chain.EthClient())
+		l2StandardBridge, err := opbindings.NewL2StandardBridge(predeploys.L2StandardBridgeAddr, opSim.Chain.EthClient())
 		if err != nil {
-			return fmt.Errorf("failed to create L2NativeSuperchainERC20 contract: %w", err)
+			return fmt.Errorf("failed to create L2StandardBridge contract: %w", err)
 		}
 
-		mintEventChan := make(chan *bindings.L2NativeSuperchainERC20CrosschainMint)
-		mintSub, err := superchainERC20.WatchCrosschainMint(&bind.WatchOpts{Context: opSim.bgTasksCtx}, mintEventChan, nil)
-		if err != nil {
-			return fmt.Errorf("failed to subscribe to L2NativeSuperchainERC20#CrosschainMint: %w", err)
-		}
-
-		burnEventChan := make(chan *bindings.L2NativeSuperchainERC20CrosschainBurn)
-		burnSub, err := superchainERC20.WatchCrosschainBurn(&bind.WatchOpts{Context: opSim.bgTasksCtx}, burnEventChan, nil)
+		finalizedEventChan := make(chan *opbindings.L2StandardBridgeDepositFinalized)
+		finalizedSub, err := l2StandardBridge.WatchDepositFinalized(&bind.WatchOpts{Context: opSim.bgTasksCtx}, finalizedEventChan, nil, nil, nil)
 		if err != nil {
-			return fmt.Errorf("failed to subscribe to L2NativeSuperchainERC20#CrosschainBurn: %w", err)
+			return fmt.Errorf("failed to subscribe to L2StandardBridge#DepositFinalized: %w", err)
 		}
 
 		for {
 			select {
-			case event := <-mintEventChan:
-				opSim.log.Info("L2NativeSuperchainERC20#CrosschainMint", "to", event.To, "amount", event.Amount)
-			case event := <-burnEventChan:
-				opSim.log.Info("L2NativeSuperchainERC20#CrosschainBurn", "from", event.From, "amount", event.Amount)
+			case event := <-finalizedEventChan:
+				opSim.log.Info("L2StandardBridge#DepositFinalized", "l1Token", event.L1Token, "l2Token", event.L2Token, "from", event.From, "to", event.To, "amount", event.Amount, "l2TxHash", event.Raw.TxHash)
 			case <-opSim.bgTasksCtx.Done():
-				mintSub.Unsubscribe()
-				burnSub.Unsubscribe()
+				finalizedSub.Unsubscribe()
 				return nil
 			}
 		}
 	})
 
+	// Log L2NativeSuperchainERC20 events
+	opSim.WatchSuperchainERC20("L2NativeSuperchainERC20", common.HexToAddress(L2NativeSuperchainERC20Addr))
+
 	// Log SuperchainTokenBridge events
 	opSim.bgTasks.Go(func() error {
 		superchainTokenBridge, err := bindings.NewSuperchainTokenBridge(predeploys.SuperchainTokenBridgeAddr, opSim.Chain.EthClient())
@@ -268,6 +295,52 @@ func (opSim *OpSimulator) startBackgroundTasks() {
 	})
 }
 
+// WatchSuperchainERC20 logs the crosschain mints & burns of the token, prefixed with its name. A token
+// already watched, i.e redeployed after a re-fork, is not watched twice
+func (opSim *OpSimulator) WatchSuperchainERC20(name string, token common.Address) {
+	opSim.watchedTokensMu.Lock()
+	defer opSim.watchedTokensMu.Unlock()
+	if opSim.watchedTokens[token] {
+		return
+	}
+	if opSim.watchedTokens == nil {
+		opSim.watchedTokens = make(map[common.Address]bool)
+	}
+	opSim.watchedTokens[token] = true
+
+	opSim.bgTasks.Go(func() error {
+		superchainERC20, err := bindings.NewSuperchainERC20(token, opSim.Chain.EthClient())
+		if err != nil {
+			return fmt.Errorf("failed to create %s contract: %w", name, err)
+		}
+
+		mintEventChan := make(chan *bindings.SuperchainERC20CrosschainMint)
+		mintSub, err := superchainERC20.WatchCrosschainMint(&bind.WatchOpts{Context: opSim.bgTasksCtx}, mintEventChan, nil)
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to %s#CrosschainMint: %w", name, err)
+		}
+
+		burnEventChan := make(chan *bindings.SuperchainERC20CrosschainBurn)
+		burnSub, err := superchainERC20.WatchCrosschainBurn(&bind.WatchOpts{Context: opSim.bgTasksCtx}, burnEventChan, nil)
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to %s#CrosschainBurn: %w", name, err)
+		}
+
+		for {
+			select {
+			case event := <-mintEventChan:
+				opSim.log.Info(name+"#CrosschainMint", "to", event.To, "amount", event.Amount)
+			case event := <-burnEventChan:
+				opSim.log.Info(name+"#CrosschainBurn", "from", event.From, "amount", event.Amount)
+			case <-opSim.bgTasksCtx.Done():
+				mintSub.Unsubscribe()
+				burnSub.Unsubscribe()
+				return nil
+			}
+		}
+	})
+}
+
 func (opSim *OpSimulator) handler(ctx context.Context) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
@@ -286,6 +359,8 @@ func (opSim *OpSimulator) handler(ctx context.Context) http.HandlerFunc {
 		rpcClient := opSim.Chain.EthClient().Client()
 		batchRes := make([]*jsonRpcMessage, len(msgs))
 		for i, msg := range msgs {
+			var tx *types.Transaction
+			var rawTx hexutil.Bytes
 			if msg.Method == "eth_sendRawTransaction" {
 				var params []hexutil.Bytes
 				if err := json.Unmarshal(msg.Params, &params); err != nil {
@@ -299,8 +374,8 @@ func (opSim *OpSimulator) handler(ctx context.Context) http.HandlerFunc {
 					continue
 				}
 
-				tx := new(types.Transaction)
-				if err := tx.UnmarshalBinary(params[0]); err != nil {
+				tx, rawTx = new(types.Transaction), params[0]
+				if err := tx.UnmarshalBinary(rawTx); err != nil {
 					opSim.log.Error("failed to decode transaction data", "err", err)
 					batchRes[i] = msg.errorResponse(err)
 					continue
@@ -321,12 +396,22 @@ func (opSim *OpSimulator) handler(ctx context.Context) http.HandlerFunc {
 				}
 			}
 
+			// Deposits are relayed to the chain directly, only user transactions are sequenced
+			if msg.Method == "eth_sendRawTransaction" || msg.Method == "eth_sendTransaction" {
+				if res, held := opSim.holdTransaction(msg, tx, rawTx); held {
+					batchRes[i] = res
+					continue
+				}
+			}
+
 			// NOTE: This fans out the batch request into individual requests. To match expected behavior, this
 			// should filter out messages that are invalid and reconstruct a single batch request to forward
 			var jsonErr *jsonError
 			batchRes[i], jsonErr = forwardRPCRequest(ctx, rpcClient, msg)
 			if jsonErr != nil {
 				batchRes[i] = msg.errorResponse(jsonErr)
+			} else if msg.Method == "eth_sendRawTransaction" {
+				opSim.recordSentTransaction(msg.Params)
 			}
 		}
 
@@ -358,6 +443,41 @@ func (opSim *OpSimulator) handler(ctx context.Context) http.HandlerFunc {
 	}
 }
 
+func (opSim *OpSimulator) recordSentTransaction(params json.RawMessage) {
+	var rawTxs []hexutil.Bytes
+	if err := json.Unmarshal(params, &rawTxs); err != nil || len(rawTxs) != 1 {
+		return
+	}
+	opSim.recordSentRawTransaction(rawTxs[0])
+}
+
+func (opSim *OpSimulator) recordSentRawTransaction(rawTx hexutil.Bytes) {
+	// Only replayed when re-forking, which a local chain cannot do
+	if opSim.Config().ForkConfig == nil {
+		return
+	}
+
+	opSim.sentTxsMu.Lock()
+	defer opSim.sentTxsMu.Unlock()
+	opSim.sentTxs = append(opSim.sentTxs, rawTx)
+}
+
+// SentTransactions returns the raw transactions successfully sent through the simulator
+// of a forked chain, in order.
+func (opSim *OpSimulator) SentTransactions() []hexutil.Bytes {
+	opSim.sentTxsMu.Lock()
+	defer opSim.sentTxsMu.Unlock()
+	return append([]hexutil.Bytes{}, opSim.sentTxs...)
+}
+
+// DropSentTransactions clears the first `n` recorded transactions, once no longer part of the chain.
+// Transactions sent since they were read are kept.
+func (opSim *OpSimulator) DropSentTransactions(n int) {
+	opSim.sentTxsMu.Lock()
+	defer opSim.sentTxsMu.Unlock()
+	opSim.sentTxs = append([]hexutil.Bytes{}, opSim.sentTxs[min(n, len(opSim.sentTxs)):]...)
+}
+
 // Forward a JSON-RPC request to the Geth RPC server
 func forwardRPCRequest(ctx context.Context, rpcClient *rpc.Client, req *jsonRpcMessage) (*jsonRpcMessage, *jsonError) {
 	var result json.RawMessage
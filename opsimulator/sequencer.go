@@ -0,0 +1,176 @@
+package opsimulator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/supersim/config"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// SequencerMode is the handling of user transactions while the sequencer is paused
+type SequencerMode string
+
+const (
+	// SequencerQueue holds transactions until the sequencer resumes
+	SequencerQueue SequencerMode = "queue"
+	// SequencerReject refuses transactions
+	SequencerReject SequencerMode = "reject"
+)
+
+var errSequencerNotPaused = errors.New("sequencer is not paused")
+
+// SequencerStatus describes the sequencer of an L2
+type SequencerStatus struct {
+	ChainID uint64 `json:"chainId"`
+	Paused  bool   `json:"paused"`
+
+	Mode             SequencerMode `json:"mode,omitempty"`
+	PausedAtL1Block  uint64        `json:"pausedAtL1Block,omitempty"`
+	SequencingWindow uint64        `json:"sequencingWindow,omitempty"`
+	QueuedTxs        []common.Hash `json:"queuedTxs,omitempty"`
+}
+
+// SequencerResumeResult lists the queued transactions included or dropped once the sequencer resumed
+type SequencerResumeResult struct {
+	ChainID uint64 `json:"chainId"`
+
+	// Set when the pause outlasted the sequencing window, in which case every queued transaction is dropped
+	WindowElapsed bool `json:"windowElapsed"`
+
+	IncludedTxs []common.Hash `json:"includedTxs"`
+	DroppedTxs  []common.Hash `json:"droppedTxs"`
+}
+
+// PauseSequencer stops the inclusion of user transactions sent to the simulator, which are queued or rejected
+// according to the mode, while deposits from the L1 are still included. Queued transactions are dropped if
+// the sequencer resumes after more than `sequencingWindow` L1 blocks, `0` for the default
+func (opSim *OpSimulator) PauseSequencer(ctx context.Context, mode SequencerMode, sequencingWindow uint64) error {
+	switch mode {
+	case "":
+		mode = SequencerQueue
+	case SequencerQueue, SequencerReject:
+	default:
+		return fmt.Errorf("unrecognized sequencer mode `%s`", mode)
+	}
+	if sequencingWindow == 0 {
+		sequencingWindow = config.DefaultSequencingWindow
+	}
+
+	l1Head, err := opSim.l1Chain.EthClient().BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch the L1 block number: %w", err)
+	}
+
+	opSim.sequencerMu.Lock()
+	defer opSim.sequencerMu.Unlock()
+	if opSim.sequencerPaused {
+		return errors.New("sequencer is already paused")
+	}
+
+	opSim.sequencerPaused = true
+	opSim.sequencerMode = mode
+	opSim.sequencerPausedAt = l1Head
+	opSim.sequencingWindow = sequencingWindow
+
+	opSim.log.Info("paused sequencer", "mode", mode, "l1Block", l1Head, "sequencingWindow", sequencingWindow)
+	return nil
+}
+
+// ResumeSequencer includes the queued transactions in order, unless the sequencing window elapsed
+func (opSim *OpSimulator) ResumeSequencer(ctx context.Context) (*SequencerResumeResult, error) {
+	l1Head, err := opSim.l1Chain.EthClient().BlockNumber(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch the L1 block number: %w", err)
+	}
+
+	// Held while catching up such that new transactions are sent after the queued ones
+	opSim.sequencerMu.Lock()
+	defer opSim.sequencerMu.Unlock()
+	if !opSim.sequencerPaused {
+		return nil, errSequencerNotPaused
+	}
+
+	result := &SequencerResumeResult{ChainID: opSim.Config().ChainID, IncludedTxs: []common.Hash{}, DroppedTxs: []common.Hash{}}
+	result.WindowElapsed = l1Head > opSim.sequencerPausedAt+opSim.sequencingWindow
+
+	for _, rawTx := range opSim.queuedTxs {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(rawTx); err != nil {
+			return nil, fmt.Errorf("failed to decode queued transaction: %w", err)
+		}
+
+		// Without a sequencer for the whole window, the L2 only includes deposits
+		if result.WindowElapsed {
+			result.DroppedTxs = append(result.DroppedTxs, tx.Hash())
+			continue
+		}
+
+		// Transactions are re-validated against the state including the deposits made while paused
+		if err := opSim.Chain.EthClient().Client().CallContext(ctx, nil, "eth_sendRawTransaction", rawTx); err != nil {
+			opSim.log.Warn("dropped queued transaction", "hash", tx.Hash(), "err", err)
+			result.DroppedTxs = append(result.DroppedTxs, tx.Hash())
+			continue
+		}
+		opSim.recordSentRawTransaction(rawTx)
+		result.IncludedTxs = append(result.IncludedTxs, tx.Hash())
+	}
+
+	opSim.sequencerPaused = false
+	opSim.queuedTxs = nil
+
+	opSim.log.Info("resumed sequencer", "included", len(result.IncludedTxs), "dropped", len(result.DroppedTxs), "windowElapsed", result.WindowElapsed)
+	return result, nil
+}
+
+// SequencerStatus returns whether the sequencer is paused, along with the queued transactions
+func (opSim *OpSimulator) SequencerStatus() *SequencerStatus {
+	opSim.sequencerMu.Lock()
+	defer opSim.sequencerMu.Unlock()
+
+	status := &SequencerStatus{ChainID: opSim.Config().ChainID, Paused: opSim.sequencerPaused}
+	if !opSim.sequencerPaused {
+		return status
+	}
+
+	status.Mode = opSim.sequencerMode
+	status.PausedAtL1Block = opSim.sequencerPausedAt
+	status.SequencingWindow = opSim.sequencingWindow
+	status.QueuedTxs = []common.Hash{}
+	for _, rawTx := range opSim.queuedTxs {
+		tx := new(types.Transaction)
+		if err := tx.UnmarshalBinary(rawTx); err == nil {
+			status.QueuedTxs = append(status.QueuedTxs, tx.Hash())
+		}
+	}
+	return status
+}
+
+// holdTransaction queues or rejects a transaction sent while the sequencer is paused. Transactions signed by
+// the node are always rejected as their hash is unknown until signed
+func (opSim *OpSimulator) holdTransaction(msg *jsonRpcMessage, tx *types.Transaction, rawTx hexutil.Bytes) (*jsonRpcMessage, bool) {
+	opSim.sequencerMu.Lock()
+	defer opSim.sequencerMu.Unlock()
+	if !opSim.sequencerPaused {
+		return nil, false
+	}
+
+	chainID := opSim.Config().ChainID
+	if opSim.sequencerMode == SequencerReject || tx == nil {
+		return msg.errorResponse(&jsonError{Code: errcodeDefault, Message: fmt.Sprintf("sequencer of chain %d is paused", chainID)}), true
+	}
+
+	result, err := json.Marshal(tx.Hash())
+	if err != nil {
+		return msg.errorResponse(err), true
+	}
+
+	opSim.queuedTxs = append(opSim.queuedTxs, rawTx)
+	opSim.log.Info("queued transaction while the sequencer is paused", "hash", tx.Hash())
+	return &jsonRpcMessage{Version: vsn, ID: msg.ID, Result: result}, true
+}
@@ -0,0 +1,323 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/supersim/bindings"
+	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/interop"
+	"github.com/ethereum-optimism/supersim/opsimulator"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// Time allowed for a bridged message to be relayed on the destination chain
+	relayTimeout = 30 * time.Second
+
+	relayPollInterval = 250 * time.Millisecond
+)
+
+var sentMessageEventID = bindings.L2ToL2CrossDomainMessengerParsedABI.Events["SentMessage"].ID
+
+// SendERC20Request describes a transfer of a SuperchainERC20 between two L2s through the SuperchainTokenBridge
+type SendERC20Request struct {
+	Token common.Address `json:"token"`
+	From  common.Address `json:"from"`
+
+	// Recipient on the destination chain, defaults to the sender
+	To *common.Address `json:"to,omitempty"`
+
+	// Amount in whole tokens, i.e `1.5`
+	Amount string `json:"amount"`
+
+	Source      uint64 `json:"source"`
+	Destination uint64 `json:"destination"`
+}
+
+// SendERC20Result describes a relayed transfer along with the balances of the sender & recipient once relayed
+type SendERC20Result struct {
+	MessageHash common.Hash `json:"messageHash"`
+	SendTxHash  common.Hash `json:"sendTxHash"`
+	RelayTxHash common.Hash `json:"relayTxHash"`
+
+	Decimals           uint8        `json:"decimals"`
+	SourceBalance      *hexutil.Big `json:"sourceBalance"`
+	DestinationBalance *hexutil.Big `json:"destinationBalance"`
+}
+
+// SendERC20 sends the SuperchainERC20 from the source to the destination chain on behalf of the sender, which
+// is impersonated. The sender's SuperchainWETH shortfall is wrapped from its ETH balance. Waits for the message
+// to be relayed, by the auto-relayer if enabled or else by this call.
+func (o *Orchestrator) SendERC20(ctx context.Context, req *SendERC20Request) (*SendERC20Result, error) {
+	if o.l2ToL2MsgIndexer == nil {
+		return nil, errInteropDisabled
+	}
+	if req.Source == req.Destination {
+		return nil, errors.New("source and destination chains must differ")
+	}
+
+	source, ok := o.l2Chains[req.Source]
+	if !ok {
+		return nil, fmt.Errorf("unknown chain %d", req.Source)
+	}
+	destination, ok := o.l2Chains[req.Destination]
+	if !ok {
+		return nil, fmt.Errorf("unknown chain %d", req.Destination)
+	}
+
+	to := req.From
+	if req.To != nil {
+		to = *req.To
+	}
+
+	decimals, err := callUint(ctx, source, req.Token, decimalsSelector)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch decimals of token %s: %w", req.Token, err)
+	}
+	if !decimals.IsUint64() || decimals.Uint64() > 255 {
+		return nil, fmt.Errorf("invalid decimals %s of token %s", decimals, req.Token)
+	}
+	amount, err := config.ParseAmount(req.Amount, uint8(decimals.Uint64()))
+	if err != nil {
+		return nil, err
+	}
+	if amount.Sign() == 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+
+	stopImpersonating, err := impersonate(ctx, source, req.From)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := stopImpersonating(); err != nil {
+			o.log.Warn("failed to stop impersonating account", "address", req.From, "err", err)
+		}
+	}()
+
+	balance, err := balanceOf(ctx, source, req.Token, req.From)
+	if err != nil {
+		return nil, err
+	}
+	if balance.Cmp(amount) < 0 {
+		if req.Token != predeploys.SuperchainWETHAddr {
+			return nil, fmt.Errorf("insufficient balance of %s: %s < %s", req.From, balance, amount)
+		}
+
+		data, err := bindings.SuperchainWETHParsedABI.Pack("deposit")
+		if err != nil {
+			return nil, fmt.Errorf("failed to pack deposit: %w", err)
+		}
+		if _, err := sendAsImpersonated(ctx, source, req.From, req.Token, new(big.Int).Sub(amount, balance), data); err != nil {
+			return nil, fmt.Errorf("failed to wrap ETH: %w", err)
+		}
+	}
+
+	data, err := bindings.SuperchainTokenBridgeParsedABI.Pack("sendERC20", req.Token, to, amount, new(big.Int).SetUint64(req.Destination))
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack sendERC20: %w", err)
+	}
+	receipt, err := sendAsImpersonated(ctx, source, req.From, predeploys.SuperchainTokenBridgeAddr, nil, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send ERC20: %w", err)
+	}
+
+	msgHash, err := sentMessageHash(ctx, source, req.Source, receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	relayTxHash, err := o.waitForRelay(ctx, msgHash)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceBalance, err := balanceOf(ctx, source, req.Token, req.From)
+	if err != nil {
+		return nil, err
+	}
+	destinationBalance, err := balanceOf(ctx, destination, req.Token, to)
+	if err != nil {
+		return nil, err
+	}
+
+	o.log.Info("sent ERC20", "token", req.Token, "from", req.From, "to", to, "amount", req.Amount, "source", req.Source, "destination", req.Destination)
+	return &SendERC20Result{
+		MessageHash:        msgHash,
+		SendTxHash:         receipt.TxHash,
+		RelayTxHash:        relayTxHash,
+		Decimals:           uint8(decimals.Uint64()),
+		SourceBalance:      (*hexutil.Big)(sourceBalance),
+		DestinationBalance: (*hexutil.Big)(destinationBalance),
+	}, nil
+}
+
+// waitForRelay polls the indexer until the message is relayed. Without the auto-relayer, the message is
+// relayed once it is indexed
+func (o *Orchestrator) waitForRelay(ctx context.Context, msgHash common.Hash) (common.Hash, error) {
+	ctx, cancel := context.WithTimeout(ctx, relayTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(relayPollInterval)
+	defer ticker.Stop()
+
+	relaying := o.l2ToL2MsgRelayer != nil
+	for {
+		doc, err := o.l2ToL2MsgIndexer.GetL2ToL2Message(ctx, msgHash)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to fetch message %s: %w", msgHash, err)
+		}
+
+		if doc != nil {
+			if doc.RelayedTxHash != nil {
+				return *doc.RelayedTxHash, nil
+			}
+			if !relaying {
+				if err := o.relayMessage(ctx, doc); err != nil {
+					return common.Hash{}, err
+				}
+				relaying = true
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return common.Hash{}, fmt.Errorf("message %s was not relayed: %w", msgHash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// relayMessage relays the message on the destination chain with the key of the auto-relayer
+func (o *Orchestrator) relayMessage(ctx context.Context, doc *interop.L2ToL2MessageDocument) error {
+	opSim, ok := o.l2OpSims[doc.Destination]
+	if !ok {
+		return fmt.Errorf("unknown chain %d", doc.Destination)
+	}
+
+	privateKey, err := interop.RelayerPrivateKey()
+	if err != nil {
+		return err
+	}
+	transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, new(big.Int).SetUint64(doc.Destination))
+	if err != nil {
+		return fmt.Errorf("failed to create transactor: %w", err)
+	}
+	transactor.Context = ctx
+
+	l2tol2CDM, err := bindings.NewL2ToL2CrossDomainMessengerTransactor(predeploys.L2toL2CrossDomainMessengerAddr, opSim.EthClient())
+	if err != nil {
+		return fmt.Errorf("failed to create transactor: %w", err)
+	}
+
+	tx, err := l2tol2CDM.RelayMessage(transactor, *doc.Identifier, doc.Payload)
+	if err != nil {
+		return fmt.Errorf("failed to relay message %s: %w", doc.MessageHash, err)
+	}
+
+	receipt, err := bind.WaitMined(ctx, opSim.EthClient(), tx)
+	if err != nil {
+		return fmt.Errorf("failed to wait for relay transaction: %w", err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("relay transaction %s of message %s reverted", tx.Hash(), doc.MessageHash)
+	}
+	return nil
+}
+
+// impersonate impersonates the account unless it can already send transactions, i.e a dev account or an account
+// impersonated by the user, which anvil lists in `eth_accounts`. The returned function stops impersonating the account
+// only if impersonated here, leaving an impersonation started by the user in place
+func impersonate(ctx context.Context, chain config.Chain, account common.Address) (func() error, error) {
+	var accounts []common.Address
+	if err := chain.EthClient().Client().CallContext(ctx, &accounts, "eth_accounts"); err != nil {
+		return nil, fmt.Errorf("failed to fetch accounts: %w", err)
+	}
+	if slices.Contains(accounts, account) {
+		return func() error { return nil }, nil
+	}
+
+	if err := chain.ImpersonateAccount(ctx, nil, account); err != nil {
+		return nil, fmt.Errorf("failed to impersonate %s: %w", account, err)
+	}
+	return func() error { return chain.StopImpersonatingAccount(context.Background(), nil, account) }, nil
+}
+
+// sendAsImpersonated sends a transaction from the impersonated account and waits for its successful inclusion
+func sendAsImpersonated(ctx context.Context, chain config.Chain, from, to common.Address, value *big.Int, data []byte) (*types.Receipt, error) {
+	return sendAsImpersonatedWithGas(ctx, chain, from, to, value, data, 0)
+}
+
+// sendAsImpersonatedWithGas sends the transaction with the gas limit, estimated by the node when zero. The
+// transaction is sent to the node directly, such that it's included while the sequencer is paused, as deposits are
+func sendAsImpersonatedWithGas(ctx context.Context, chain config.Chain, from, to common.Address, value *big.Int, data []byte, gas uint64) (*types.Receipt, error) {
+	if opSim, ok := chain.(*opsimulator.OpSimulator); ok {
+		chain = opSim.Chain
+	}
+
+	args := map[string]any{"from": from, "to": to, "data": hexutil.Bytes(data)}
+	if value != nil {
+		args["value"] = (*hexutil.Big)(value)
+	}
+	if gas > 0 {
+		args["gas"] = hexutil.Uint64(gas)
+	}
+
+	var txHash common.Hash
+	if err := chain.EthClient().Client().CallContext(ctx, &txHash, "eth_sendTransaction", args); err != nil {
+		return nil, err
+	}
+
+	tx, _, err := chain.EthClient().TransactionByHash(ctx, txHash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch transaction %s: %w", txHash, err)
+	}
+	receipt, err := bind.WaitMined(ctx, chain.EthClient(), tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wait for transaction %s: %w", txHash, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return nil, fmt.Errorf("transaction %s reverted", txHash)
+	}
+	return receipt, nil
+}
+
+// sentMessageHash returns the hash of the message initiated by the transaction
+func sentMessageHash(ctx context.Context, chain config.Chain, chainID uint64, receipt *types.Receipt) (common.Hash, error) {
+	for _, log := range receipt.Logs {
+		if log.Address != predeploys.L2toL2CrossDomainMessengerAddr || len(log.Topics) == 0 || log.Topics[0] != sentMessageEventID {
+			continue
+		}
+
+		identifier, err := interop.GetIdentifier(ctx, chain.EthClient(), chainID, log)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to get message identifier: %w", err)
+		}
+		msg, err := interop.NewL2ToL2MessageFromSentMessageEventData(log, identifier)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to decode SentMessage event: %w", err)
+		}
+		return msg.Hash()
+	}
+
+	return common.Hash{}, fmt.Errorf("no SentMessage event in transaction %s", receipt.TxHash)
+}
+
+func balanceOf(ctx context.Context, chain config.Chain, token, holder common.Address) (*big.Int, error) {
+	data := append(append([]byte{}, balanceOfSelector...), common.LeftPadBytes(holder.Bytes(), 32)...)
+	balance, err := callUint(ctx, chain, token, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch balance of %s on chain %s: %w", holder, chain.Config().Name, err)
+	}
+	return balance, nil
+}
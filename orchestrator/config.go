@@ -0,0 +1,113 @@
+package orchestrator
+
+import (
+	"sort"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	registry "github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/ethereum-optimism/supersim/config"
+	opsimulator "github.com/ethereum-optimism/supersim/opsimulator"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ConfigDocument is the machine readable counterpart of `ConfigAsString`
+type ConfigDocument struct {
+	// Set by the admin server, not known to the orchestrator
+	AdminRPC string `json:"adminRPC,omitempty"`
+
+	L1  *ChainDocument   `json:"l1"`
+	L2s []*ChainDocument `json:"l2s"`
+
+	// Nil when interop is disabled
+	Interop *InteropDocument `json:"interop,omitempty"`
+
+	Accounts []config.DevAccount `json:"accounts"`
+
+	// Deposited to their OptimismMintableERC20 through the L1StandardBridge
+	L1Tokens []*L1TokenDeployment `json:"l1Tokens,omitempty"`
+}
+
+type ChainDocument struct {
+	Name    string `json:"name"`
+	ChainID uint64 `json:"chainID"`
+	RPCUrl  string `json:"rpcUrl"`
+	LogPath string `json:"logPath,omitempty"`
+
+	// Only set for forked chains
+	ForkBlockNumber uint64 `json:"forkBlockNumber,omitempty"`
+
+	FundedAccounts []config.FundedAccount `json:"fundedAccounts,omitempty"`
+
+	// Only set for L2s
+	L1Addresses   *registry.AddressList `json:"l1Addresses,omitempty"`
+	DependencySet []uint64              `json:"dependencySet,omitempty"`
+}
+
+type InteropDocument struct {
+	AutoRelay  bool                      `json:"autoRelay"`
+	Predeploys map[string]common.Address `json:"predeploys"`
+
+	// Deployed at the same address on every L2
+	Tokens []*SuperchainERC20Deployment `json:"tokens,omitempty"`
+}
+
+// ConfigDocument describes the running chains, ordered as in `ConfigAsString`
+func (o *Orchestrator) ConfigDocument() *ConfigDocument {
+	doc := &ConfigDocument{
+		L1:       o.chainDocument(o.l1Chain),
+		Accounts: o.l1Chain.Config().SecretsConfig.DevAccounts(),
+		L1Tokens: o.L1Tokens(),
+	}
+
+	for _, opSim := range o.sortedOpSims() {
+		doc.L2s = append(doc.L2s, o.chainDocument(opSim))
+	}
+
+	if o.config.InteropEnabled {
+		doc.Interop = &InteropDocument{
+			AutoRelay: o.config.InteropAutoRelay,
+			Predeploys: map[string]common.Address{
+				"L2ToL2CrossDomainMessenger": predeploys.L2toL2CrossDomainMessengerAddr,
+				"CrossL2Inbox":               predeploys.CrossL2InboxAddr,
+				"SuperchainTokenBridge":      predeploys.SuperchainTokenBridgeAddr,
+				"SuperchainWETH":             predeploys.SuperchainWETHAddr,
+				"L2NativeSuperchainERC20":    common.HexToAddress(opsimulator.L2NativeSuperchainERC20Addr),
+			},
+			Tokens: o.SuperchainERC20s(),
+		}
+	}
+
+	return doc
+}
+
+func (o *Orchestrator) chainDocument(chain config.Chain) *ChainDocument {
+	cfg := chain.Config()
+	doc := &ChainDocument{
+		Name:    cfg.Name,
+		ChainID: cfg.ChainID,
+		RPCUrl:  chain.Endpoint(),
+		LogPath: chain.LogPath(),
+
+		FundedAccounts: cfg.FundedAccounts,
+	}
+	if height, ok := o.forkHeight(cfg.ChainID); ok {
+		doc.ForkBlockNumber = height
+	}
+	if cfg.L2Config != nil {
+		doc.L1Addresses = cfg.L2Config.L1Addresses
+		doc.DependencySet = cfg.L2Config.DependencySet
+	}
+	return doc
+}
+
+// sortedOpSims returns the L2s sorted by port number, retaining the ordering of chain flags
+func (o *Orchestrator) sortedOpSims() []*opsimulator.OpSimulator {
+	opSims := make([]*opsimulator.OpSimulator, 0, len(o.l2OpSims))
+	for _, chain := range o.l2OpSims {
+		opSims = append(opSims, chain)
+	}
+
+	sort.Slice(opSims, func(i, j int) bool { return opSims[i].Config().Port < opSims[j].Config().Port })
+	return opSims
+}
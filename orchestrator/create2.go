@@ -0,0 +1,159 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/supersim/config"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Runtime code of the deterministic deployment proxy, the CREATE2 factory used by foundry. Included in the
+// genesis of the L2s and deployed by anvil, but set when missing from a forked chain
+const deterministicDeploymentProxyCode = "0x7fffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffe03601600081602082378035828234f58015156039578182fd5b8082525050506014600cf3"
+
+// create2Address is the address of the contract created by the deterministic deployment proxy
+func create2Address(salt common.Hash, initCode []byte) common.Address {
+	return crypto.CreateAddress2(predeploys.DeterministicDeploymentProxyAddr, salt, crypto.Keccak256(initCode))
+}
+
+// deployCreate2 creates the contract through the deterministic deployment proxy from the impersonated sender,
+// returning the address reported by the factory. The transaction hash is empty when the contract was already deployed
+func deployCreate2(ctx context.Context, chain config.Chain, from common.Address, salt common.Hash, initCode []byte) (common.Address, common.Hash, error) {
+	addr := create2Address(salt, initCode)
+	code, err := chain.EthClient().CodeAt(ctx, addr, nil)
+	if err != nil {
+		return common.Address{}, common.Hash{}, fmt.Errorf("failed to fetch code at %s: %w", addr, err)
+	}
+	if len(code) > 0 {
+		return addr, common.Hash{}, nil
+	}
+
+	factoryCode, err := chain.EthClient().CodeAt(ctx, predeploys.DeterministicDeploymentProxyAddr, nil)
+	if err != nil {
+		return common.Address{}, common.Hash{}, fmt.Errorf("failed to fetch code of the create2 factory: %w", err)
+	}
+	if len(factoryCode) == 0 {
+		if err := chain.SetCode(ctx, nil, predeploys.DeterministicDeploymentProxyAddr, deterministicDeploymentProxyCode); err != nil {
+			return common.Address{}, common.Hash{}, fmt.Errorf("failed to set code of the create2 factory: %w", err)
+		}
+	}
+
+	stopImpersonating, err := impersonate(ctx, chain, from)
+	if err != nil {
+		return common.Address{}, common.Hash{}, err
+	}
+	defer func() { _ = stopImpersonating() }()
+
+	// The factory returns the created address and reverts when the creation fails. A factory
+	// with unexpected code on a forked chain would create the contract elsewhere
+	data := append(salt.Bytes(), initCode...)
+	factory := predeploys.DeterministicDeploymentProxyAddr
+	ret, err := chain.EthClient().CallContract(ctx, ethereum.CallMsg{From: from, To: &factory, Data: data}, nil)
+	if err != nil {
+		return common.Address{}, common.Hash{}, fmt.Errorf("failed to simulate the creation of %s: %w", addr, err)
+	}
+	if len(ret) != common.AddressLength {
+		return common.Address{}, common.Hash{}, fmt.Errorf("unexpected create2 factory output %x", ret)
+	}
+	addr = common.BytesToAddress(ret)
+
+	receipt, err := sendAsImpersonated(ctx, chain, from, factory, nil, data)
+	if err != nil {
+		return common.Address{}, common.Hash{}, fmt.Errorf("failed to create contract at %s: %w", addr, err)
+	}
+	return addr, receipt.TxHash, nil
+}
+
+// DeployCreate2Request is the creation code, including any abi encoded constructor arguments, deployed
+// through the deterministic deployment proxy
+type DeployCreate2Request struct {
+	InitCode hexutil.Bytes `json:"initCode"`
+	Salt     common.Hash   `json:"salt"`
+
+	// Sender of the factory transactions, impersonated. Defaults to the first dev account
+	From *common.Address `json:"from,omitempty"`
+
+	IncludeL1 bool `json:"includeL1"`
+}
+
+type DeployCreate2Result struct {
+	Address     common.Address     `json:"address"`
+	Deployments []*ChainDeployment `json:"deployments"`
+}
+
+type ChainDeployment struct {
+	ChainID uint64         `json:"chainID"`
+	Address common.Address `json:"address"`
+
+	// Empty when the contract was already deployed
+	TxHash common.Hash `json:"txHash"`
+
+	// Set when code was already present at the CREATE2 address, which is derived from the init code
+	// such that the code was created from the same init code. The contract is not created again
+	AlreadyDeployed bool `json:"alreadyDeployed"`
+}
+
+// DeployCreate2 deploys the contract to every L2, and optionally the L1, through the CREATE2 factory
+// present on every chain. It fails when the contract is not created at the same address on every chain
+func (o *Orchestrator) DeployCreate2(ctx context.Context, req *DeployCreate2Request) (*DeployCreate2Result, error) {
+	if len(req.InitCode) == 0 {
+		return nil, fmt.Errorf("empty init code")
+	}
+
+	from := req.From
+	if from == nil {
+		accounts := o.l1Chain.Config().SecretsConfig.DevAccounts()
+		if len(accounts) == 0 {
+			return nil, fmt.Errorf("no dev accounts, the sender must be specified")
+		}
+		from = &accounts[0].Address
+	}
+
+	var chains []config.Chain
+	if req.IncludeL1 {
+		chains = append(chains, o.l1Chain)
+	}
+	for _, opSim := range o.sortedOpSims() {
+		chains = append(chains, opSim)
+	}
+
+	deployments := make([]*ChainDeployment, len(chains))
+	err := forEachChain(chains, func(i int, chain config.Chain) error {
+		addr, txHash, err := deployCreate2(ctx, chain, *from, req.Salt, req.InitCode)
+		if err != nil {
+			return fmt.Errorf("failed to deploy on chain %s: %w", chain.Config().Name, err)
+		}
+		deployments[i] = &ChainDeployment{ChainID: chain.Config().ChainID, Address: addr, TxHash: txHash, AlreadyDeployed: txHash == (common.Hash{})}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := &DeployCreate2Result{Address: deployments[0].Address, Deployments: deployments}
+	for _, deployment := range deployments {
+		if deployment.Address != result.Address {
+			return result, fmt.Errorf("addresses diverge, created at %s on chain %d and %s on chain %d", result.Address, deployments[0].ChainID, deployment.Address, deployment.ChainID)
+		}
+	}
+
+	var created int
+	for _, deployment := range deployments {
+		if !deployment.AlreadyDeployed {
+			created++
+		}
+	}
+	if created == 0 {
+		o.log.Info("contract already deployed", "address", result.Address, "chains", len(deployments))
+		return result, nil
+	}
+
+	o.log.Info("deployed contract", "address", result.Address, "chains", created, "alreadyDeployed", len(deployments)-created)
+	return result, nil
+}
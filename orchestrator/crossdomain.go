@@ -0,0 +1,147 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-e2e/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/crossdomain"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// estimationAddress is the `Constants.ESTIMATION_ADDRESS` of the messengers. Relays originating from it revert
+// instead of recording the message as failed, such that gas estimation fails for a message that still fails
+var estimationAddress = common.HexToAddress("0x0000000000000000000000000000000000000001")
+
+// ListCrossDomainMessages returns every message sent through the L1CrossDomainMessenger and L2CrossDomainMessenger
+// of the L2s, ordered by source chain, destination chain and nonce
+func (o *Orchestrator) ListCrossDomainMessages(ctx context.Context) ([]*crossdomain.MessageDocument, error) {
+	return o.crossDomainMsgIndexer.ListMessages(ctx)
+}
+
+// GetCrossDomainMessage returns the indexed state of a message, nil if the message has not been indexed. The L2
+// the message is sent to or from, if non-zero, disambiguates identical messages of several L2s
+func (o *Orchestrator) GetCrossDomainMessage(ctx context.Context, msgHash common.Hash, l2ChainID uint64) (*crossdomain.MessageDocument, error) {
+	docs, err := o.crossDomainMsgIndexer.ListMessages(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *crossdomain.MessageDocument
+	for _, doc := range docs {
+		if doc.MessageHash != msgHash || (l2ChainID != 0 && doc.Source != l2ChainID && doc.Destination != l2ChainID) {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("message %s is sent by several L2s, specify its chain", msgHash)
+		}
+		found = doc
+	}
+	return found, nil
+}
+
+// ReplayCrossDomainMessage relays a failed message again on its destination chain, returning its updated state
+func (o *Orchestrator) ReplayCrossDomainMessage(ctx context.Context, msgHash common.Hash, l2ChainID uint64) (*crossdomain.MessageDocument, error) {
+	doc, err := o.GetCrossDomainMessage(ctx, msgHash, l2ChainID)
+	if err != nil {
+		return nil, err
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("message %s not found", msgHash)
+	}
+	if doc.Status != crossdomain.FailedRelay.String() {
+		return nil, fmt.Errorf("message %s is %s, only failed messages can be replayed", msgHash, doc.Status)
+	}
+
+	chain, messenger, err := o.destinationMessenger(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	messengerABI, err := opbindings.CrossDomainMessengerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+
+	// The value is held by the messenger since the failed relay, the replay itself has none
+	data, err := messengerABI.Pack("relayMessage", doc.Nonce.ToInt(), doc.Sender, doc.Target, doc.Value.ToInt(), doc.GasLimit.ToInt(), []byte(doc.Message))
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack relayMessage: %w", err)
+	}
+	gas, err := chain.EthClient().EstimateGas(ctx, ethereum.CallMsg{From: estimationAddress, To: &messenger, Data: data})
+	if err != nil {
+		return nil, fmt.Errorf("message %s still fails to relay: %w", msgHash, err)
+	}
+
+	from := chain.Config().SecretsConfig.DevAccounts()[0].Address
+	stopImpersonating, err := impersonate(ctx, chain, from)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = stopImpersonating() }()
+
+	receipt, err := sendAsImpersonatedWithGas(ctx, chain, from, messenger, nil, data, gas)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay message %s: %w", msgHash, err)
+	}
+
+	o.log.Info("replayed cross domain message", "msgHash", msgHash, "destination", doc.Destination, "txHash", receipt.TxHash)
+	return o.waitForCrossDomainMessage(ctx, msgHash, doc)
+}
+
+// destinationMessenger returns the chain and the messenger relaying the message
+func (o *Orchestrator) destinationMessenger(doc *crossdomain.MessageDocument) (config.Chain, common.Address, error) {
+	if doc.Destination != o.l1Chain.Config().ChainID {
+		opSim, ok := o.l2OpSims[doc.Destination]
+		if !ok {
+			return nil, common.Address{}, fmt.Errorf("unknown chain %d", doc.Destination)
+		}
+		return opSim, predeploys.L2CrossDomainMessengerAddr, nil
+	}
+
+	// Messages to the L1 are relayed by the L1CrossDomainMessenger of their source
+	opSim, ok := o.l2OpSims[doc.Source]
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf("unknown chain %d", doc.Source)
+	}
+	addresses := opSim.Config().L2Config.L1Addresses
+	if addresses == nil {
+		return nil, common.Address{}, fmt.Errorf("no L1 deployments for chain %d", doc.Source)
+	}
+	return o.l1Chain, common.Address(addresses.L1CrossDomainMessengerProxy), nil
+}
+
+// waitForCrossDomainMessage polls the indexer until the replay of the message is indexed
+func (o *Orchestrator) waitForCrossDomainMessage(ctx context.Context, msgHash common.Hash, replayed *crossdomain.MessageDocument) (*crossdomain.MessageDocument, error) {
+	l2ChainID := replayed.Destination
+	if l2ChainID == o.l1Chain.Config().ChainID {
+		l2ChainID = replayed.Source
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, relayTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(relayPollInterval)
+	defer ticker.Stop()
+
+	for {
+		doc, err := o.GetCrossDomainMessage(ctx, msgHash, l2ChainID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch message %s: %w", msgHash, err)
+		}
+		if doc != nil && doc.RelayedTxHash != nil {
+			return doc, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("replay of message %s was not indexed: %w", msgHash, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
@@ -2,48 +2,72 @@ package orchestrator
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"os"
 	"strings"
+	"time"
 
+	opbindings "github.com/ethereum-optimism/optimism/op-e2e/bindings"
 	registry "github.com/ethereum-optimism/superchain-registry/superchain"
 	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/genesis"
+	"github.com/ethereum-optimism/supersim/interop"
+	"github.com/ethereum-optimism/supersim/rpccache"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 )
 
-const blockTime = 2
-
-func NetworkConfigFromForkCLIConfig(log log.Logger, envPrefix string, cliConfig *config.CLIConfig) (config.NetworkConfig, error) {
+// NetworkConfigFromForkCLIConfig constructs the network config of the fork. If an rpc cache is provided,
+// all upstream rpc requests, both from supersim & anvil, are served through the cache.
+func NetworkConfigFromForkCLIConfig(log log.Logger, envPrefix string, cliConfig *config.CLIConfig, cache *rpccache.Cache) (config.NetworkConfig, error) {
 	forkConfig := cliConfig.ForkConfig
 	superchain := registry.Superchains[forkConfig.Network]
 	networkConfig := config.NetworkConfig{InteropEnabled: forkConfig.InteropEnabled}
 
-	// L1
-	l1RpcUrl := superchain.Config.L1.PublicRPC
-	if url, ok := os.LookupEnv(fmt.Sprintf("%s_RPC_URL_%s", envPrefix, strings.ToUpper(forkConfig.Network))); ok && url != "" {
-		log.Info("detected rpc override", "name", forkConfig.Network)
-		l1RpcUrl = url
+	// L1. An explicit fork url takes precedence over the registry's public rpc
+	var l1RpcUrl string
+	if forkConfig.L1ForkURL != "" {
+		l1RpcUrl = forkConfig.L1ForkURL
+	} else {
+		l1RpcUrl = superchain.Config.L1.PublicRPC
+		if url, ok := os.LookupEnv(fmt.Sprintf("%s_RPC_URL_%s", envPrefix, strings.ToUpper(forkConfig.Network))); ok && url != "" {
+			log.Info("detected rpc override", "name", forkConfig.Network)
+			l1RpcUrl = url
+		}
+	}
+
+	l1RpcUrl, err := cachedRpcUrl(cache, forkConfig.Network, l1RpcUrl)
+	if err != nil {
+		return networkConfig, err
 	}
 
 	l1Client, err := ethclient.Dial(l1RpcUrl)
 	if err != nil {
-		return networkConfig, fmt.Errorf("failed to dial l1 public rpc: %w", err)
+		return networkConfig, fmt.Errorf("failed to dial l1 rpc: %w", err)
 	}
 
 	chainId, err := l1Client.ChainID(context.Background())
 	if err != nil {
 		return networkConfig, fmt.Errorf("failed to fetch l1 chain id: %w", err)
 	}
-	if superchain.Config.L1.ChainID != chainId.Uint64() {
+	if superchain != nil && superchain.Config.L1.ChainID != chainId.Uint64() {
 		return networkConfig, fmt.Errorf("mismatched network chain id. Expected %d, Got %s", superchain.Config.L1.ChainID, chainId)
 	}
 
 	var l1ForkHeight *big.Int
-	if forkConfig.L1ForkHeight > 0 {
+	if forkConfig.ForkTimestamp > 0 {
+		height, err := blockNumberAtTimestamp(context.Background(), l1Client, forkConfig.ForkTimestamp)
+		if err != nil {
+			return networkConfig, fmt.Errorf("failed to find l1 height at timestamp %d: %w", forkConfig.ForkTimestamp, err)
+		}
+		l1ForkHeight = new(big.Int).SetUint64(height)
+	} else if forkConfig.L1ForkHeight > 0 {
 		l1ForkHeight = new(big.Int).SetUint64(forkConfig.L1ForkHeight)
 	}
 	l1Header, err := l1Client.HeaderByNumber(context.Background(), l1ForkHeight)
@@ -53,9 +77,11 @@ func NetworkConfigFromForkCLIConfig(log log.Logger, envPrefix string, cliConfig
 
 	networkConfig.L1Config = config.ChainConfig{
 		Name:          forkConfig.Network,
-		ChainID:       superchain.Config.L1.ChainID,
+		ChainID:       chainId.Uint64(),
 		SecretsConfig: config.DefaultSecretsConfig,
 		LogsDirectory: cliConfig.LogsDirectory,
+		BlockTime:     config.DefaultBlockTime,
+		MiningMode:    config.IntervalMiningMode,
 		ForkConfig: &config.ForkConfig{
 			RPCUrl:      l1RpcUrl,
 			BlockNumber: l1Header.Number.Uint64(),
@@ -69,10 +95,9 @@ func NetworkConfigFromForkCLIConfig(log log.Logger, envPrefix string, cliConfig
 			return networkConfig, fmt.Errorf("unrecoginized chain %s. superchain %s", chain, superchain.Superchain)
 		}
 
-		l2RpcUrl := chainCfg.PublicRPC
-		if url, ok := os.LookupEnv(fmt.Sprintf("%s_RPC_URL_%s", envPrefix, strings.ToUpper(chainCfg.Chain))); ok && url != "" {
-			log.Info("detected rpc override", "name", chainCfg.Chain)
-			l2RpcUrl = url
+		l2RpcUrl, err := cachedRpcUrl(cache, chainCfg.Chain, l2RpcUrlWithOverride(log, envPrefix, chainCfg.Chain, chainCfg.PublicRPC))
+		if err != nil {
+			return networkConfig, err
 		}
 
 		l2Client, err := ethclient.Dial(l2RpcUrl)
@@ -88,46 +113,245 @@ func NetworkConfigFromForkCLIConfig(log log.Logger, envPrefix string, cliConfig
 			return networkConfig, fmt.Errorf("mismatched l2 chain id for %s. Expected %d, Got %s", chainCfg.Chain, chainCfg.ChainID, chainId)
 		}
 
-		l2ForkHeight, err := latestL2HeightFromL1Header(chainCfg, l2Client, l1Header)
+		var explicitHeight *uint64
+		if height, ok := forkConfig.L2ForkHeights[chainCfg.Chain]; ok {
+			explicitHeight = &height
+		}
+
+		l2ForkHeight, err := resolveL2ForkHeight(l2Client, forkConfig.ForkTimestamp, explicitHeight, l1Header, chainCfg.Genesis.L2Time, chainCfg.BlockTime)
 		if err != nil {
 			return networkConfig, fmt.Errorf("failed to find right l2 height for chain %s: %w", chainCfg.Chain, err)
 		}
 
-		l2ChainConfig := config.ChainConfig{
-			Name:          chainCfg.Chain,
-			ChainID:       chainCfg.ChainID,
-			SecretsConfig: config.DefaultSecretsConfig,
-			LogsDirectory: cliConfig.LogsDirectory,
-			ForkConfig: &config.ForkConfig{
-				RPCUrl:      l2RpcUrl,
-				BlockNumber: l2ForkHeight,
-			},
-			L2Config: &config.L2Config{
-				L1ChainID:   superchain.Config.L1.ChainID,
-				L1Addresses: registry.Addresses[chainCfg.ChainID],
-			},
-		}
-
-		if forkConfig.InteropEnabled {
-			var dependencySet []uint64
-			for _, chain := range forkConfig.Chains {
-				chainCfg := config.OPChainByName(superchain, chain)
-				if chainCfg.ChainID != l2ChainConfig.ChainID {
-					dependencySet = append(dependencySet, chainCfg.ChainID)
-				}
-			}
+		l2ChainConfig := forkedL2ChainConfig(cliConfig, chainCfg.Chain, chainCfg.ChainID, chainCfg.BlockTime, chainCfg.Genesis.L2Time, l2RpcUrl, l2ForkHeight)
+		l2ChainConfig.L2Config = &config.L2Config{
+			L1ChainID:   networkConfig.L1Config.ChainID,
+			L1Addresses: registry.Addresses[chainCfg.ChainID],
+		}
+
+		networkConfig.L2Configs = append(networkConfig.L2Configs, l2ChainConfig)
+	}
 
-			l2ChainConfig.L2Config.DependencySet = dependencySet
+	for _, customChain := range forkConfig.CustomChains {
+		l2ChainConfig, err := customL2ChainConfig(log, envPrefix, cliConfig, cache, customChain, l1Client, l1Header, networkConfig.L1Config.ChainID)
+		if err != nil {
+			return networkConfig, fmt.Errorf("failed to configure custom chain %s: %w", customChain.Name, err)
 		}
 
 		networkConfig.L2Configs = append(networkConfig.L2Configs, l2ChainConfig)
 	}
 
+	// Local L2s run from the generated genesis. Their L1 deployments are contained in the
+	// generated L1 genesis which is applied on top of the forked L1 state on startup.
+	if forkConfig.LocalChains > 0 {
+		networkConfig.L1Config.GenesisJSON = genesis.GeneratedGenesisDeployment.L1.GenesisJSON
+		for i := range int(forkConfig.LocalChains) {
+			l2ChainConfig := config.LocalL2ChainConfig(genesis.GeneratedGenesisDeployment.L2s[i], i, networkConfig.L1Config.ChainID, uint64(time.Now().Unix()), cliConfig.LogsDirectory)
+			networkConfig.L2Configs = append(networkConfig.L2Configs, l2ChainConfig)
+		}
+	}
+
+	if forkConfig.InteropEnabled {
+		networkConfig.SetDependencySets()
+	}
+
+	// Anvil's local storage cache would otherwise serve reads without going through the rpc cache
+	if cache != nil {
+		networkConfig.L1Config.ForkConfig.NoStorageCaching = true
+		for i := range networkConfig.L2Configs {
+			if forkConfig := networkConfig.L2Configs[i].ForkConfig; forkConfig != nil {
+				forkConfig.NoStorageCaching = true
+			}
+		}
+	}
+
 	return networkConfig, nil
 }
 
-func latestL2HeightFromL1Header(l2Cfg *registry.ChainConfig, l2Client *ethclient.Client, l1Header *types.Header) (uint64, error) {
-	if l1Header.Time < l2Cfg.Genesis.L2Time {
+// customL2ChainConfig constructs the fork config for a chain outside of the registry. Any
+// information missing from the chain config is queried from the L2 rpc or L1 SystemConfig
+func customL2ChainConfig(log log.Logger, envPrefix string, cliConfig *config.CLIConfig, cache *rpccache.Cache, customChain *config.CustomChainConfig, l1Client *ethclient.Client, l1Header *types.Header, l1ChainID uint64) (config.ChainConfig, error) {
+	ctx := context.Background()
+	if customChain.L1ChainID != 0 && customChain.L1ChainID != l1ChainID {
+		return config.ChainConfig{}, fmt.Errorf("mismatched l1 chain id. Expected %d, Got %d", customChain.L1ChainID, l1ChainID)
+	}
+
+	l2RpcUrl, err := cachedRpcUrl(cache, customChain.Name, l2RpcUrlWithOverride(log, envPrefix, customChain.Name, customChain.RPCUrl))
+	if err != nil {
+		return config.ChainConfig{}, err
+	}
+
+	l2Client, err := ethclient.Dial(l2RpcUrl)
+	if err != nil {
+		return config.ChainConfig{}, fmt.Errorf("failed to dial l2 rpc: %w", err)
+	}
+
+	chainId, err := l2Client.ChainID(ctx)
+	if err != nil {
+		return config.ChainConfig{}, fmt.Errorf("failed to fetch l2 chain id: %w", err)
+	}
+	if customChain.ChainID != 0 && customChain.ChainID != chainId.Uint64() {
+		return config.ChainConfig{}, fmt.Errorf("mismatched l2 chain id. Expected %d, Got %s", customChain.ChainID, chainId)
+	}
+
+	l2ForkHeight, err := resolveL2ForkHeight(l2Client, cliConfig.ForkConfig.ForkTimestamp, customChain.ForkHeight, l1Header, customChain.GenesisL2Time, customChain.BlockTime)
+	if err != nil {
+		return config.ChainConfig{}, fmt.Errorf("failed to find right l2 height: %w", err)
+	}
+
+	addresses := *customChain.Addresses
+	if err := resolveL1Addresses(ctx, l1Client, &addresses); err != nil {
+		return config.ChainConfig{}, fmt.Errorf("failed to resolve l1 addresses: %w", err)
+	}
+
+	l2ChainConfig := forkedL2ChainConfig(cliConfig, customChain.Name, chainId.Uint64(), customChain.BlockTime, customChain.GenesisL2Time, l2RpcUrl, l2ForkHeight)
+	l2ChainConfig.L2Config = &config.L2Config{
+		L1ChainID:   l1ChainID,
+		L1Addresses: &addresses,
+	}
+
+	return l2ChainConfig, nil
+}
+
+// resolveL1Addresses fills in any unspecified L1 addresses by querying the SystemConfig contract
+func resolveL1Addresses(ctx context.Context, l1Client *ethclient.Client, addresses *registry.AddressList) error {
+	if addresses.SystemConfigProxy == (registry.Address{}) {
+		return nil
+	}
+
+	systemConfig, err := opbindings.NewSystemConfigCaller(common.Address(addresses.SystemConfigProxy), l1Client)
+	if err != nil {
+		return fmt.Errorf("failed to bind to SystemConfig: %w", err)
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	lookups := []struct {
+		name   string
+		target *registry.Address
+		call   func(*bind.CallOpts) (common.Address, error)
+	}{
+		{"L1CrossDomainMessenger", &addresses.L1CrossDomainMessengerProxy, systemConfig.L1CrossDomainMessenger},
+		{"L1StandardBridge", &addresses.L1StandardBridgeProxy, systemConfig.L1StandardBridge},
+		{"L1ERC721Bridge", &addresses.L1ERC721BridgeProxy, systemConfig.L1ERC721Bridge},
+		{"OptimismPortal", &addresses.OptimismPortalProxy, systemConfig.OptimismPortal},
+		{"OptimismMintableERC20Factory", &addresses.OptimismMintableERC20FactoryProxy, systemConfig.OptimismMintableERC20Factory},
+		{"DisputeGameFactory", &addresses.DisputeGameFactoryProxy, systemConfig.DisputeGameFactory},
+	}
+
+	for _, lookup := range lookups {
+		if *lookup.target != (registry.Address{}) {
+			continue
+		}
+
+		addr, err := lookup.call(opts)
+		if err != nil {
+			// older SystemConfig versions do not expose every address
+			if lookup.name == "DisputeGameFactory" {
+				continue
+			}
+			return fmt.Errorf("failed to query %s: %w", lookup.name, err)
+		}
+		*lookup.target = registry.Address(addr)
+	}
+
+	return nil
+}
+
+func forkedL2ChainConfig(cliConfig *config.CLIConfig, name string, chainID, blockTime, genesisL2Time uint64, rpcUrl string, forkHeight uint64) config.ChainConfig {
+	return config.ChainConfig{
+		Name:          name,
+		ChainID:       chainID,
+		SecretsConfig: config.DefaultSecretsConfig,
+		LogsDirectory: cliConfig.LogsDirectory,
+		BlockTime:     blockTime,
+		MiningMode:    config.IntervalMiningMode,
+		ForkConfig: &config.ForkConfig{
+			RPCUrl:        rpcUrl,
+			BlockNumber:   forkHeight,
+			GenesisL2Time: genesisL2Time,
+			L2BlockTime:   blockTime,
+		},
+	}
+}
+
+// cachedRpcUrl returns the url of the rpc cache proxy for the upstream if a cache is used
+func cachedRpcUrl(cache *rpccache.Cache, name, rpcUrl string) (string, error) {
+	if cache == nil {
+		return rpcUrl, nil
+	}
+
+	url, err := cache.Proxy(name, rpcUrl)
+	if err != nil {
+		return "", fmt.Errorf("failed to start rpc cache proxy for %s: %w", name, err)
+	}
+	return url, nil
+}
+
+// l2RpcUrlWithOverride returns the rpc url of the chain, replaced by the ($<PREFIX>_RPC_URL_<CHAIN>) env variable if set
+func l2RpcUrlWithOverride(log log.Logger, envPrefix, name, rpcUrl string) string {
+	if url, ok := os.LookupEnv(fmt.Sprintf("%s_RPC_URL_%s", envPrefix, strings.ToUpper(name))); ok && url != "" {
+		log.Info("detected rpc override", "name", name)
+		return url
+	}
+	return rpcUrl
+}
+
+// resolveL2ForkHeight returns the fork height of an L2. An explicit height takes precedence, followed
+// by the fork timestamp, `0` if unset. Otherwise the height is derived from the L1 fork block.
+func resolveL2ForkHeight(l2Client *ethclient.Client, forkTimestamp uint64, explicitHeight *uint64, l1Header *types.Header, genesisL2Time, blockTime uint64) (uint64, error) {
+	if explicitHeight != nil {
+		return *explicitHeight, nil
+	}
+	if forkTimestamp > 0 {
+		return blockNumberAtTimestamp(context.Background(), l2Client, forkTimestamp)
+	}
+	return latestL2HeightFromL1Header(l2Client, l1Header, genesisL2Time, blockTime)
+}
+
+type headerReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// blockNumberAtTimestamp returns the latest block with a timestamp at or before the specified
+// timestamp. Block timestamps are monotonic, so the block is found by binary search over headers.
+func blockNumberAtTimestamp(ctx context.Context, client headerReader, timestamp uint64) (uint64, error) {
+	latestHeader, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query latest header: %w", err)
+	}
+	if latestHeader.Time <= timestamp {
+		return latestHeader.Number.Uint64(), nil
+	}
+
+	genesisHeader, err := client.HeaderByNumber(ctx, common.Big0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query genesis header: %w", err)
+	}
+	if genesisHeader.Time > timestamp {
+		return 0, fmt.Errorf("timestamp %d precedes genesis time %d", timestamp, genesisHeader.Time)
+	}
+
+	// invariant: time(low) <= timestamp < time(high)
+	low, high := uint64(0), latestHeader.Number.Uint64()
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		header, err := client.HeaderByNumber(ctx, new(big.Int).SetUint64(mid))
+		if err != nil {
+			return 0, fmt.Errorf("failed to query header %d: %w", mid, err)
+		}
+
+		if header.Time <= timestamp {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	return low, nil
+}
+
+func latestL2HeightFromL1Header(l2Client *ethclient.Client, l1Header *types.Header, genesisL2Time, blockTime uint64) (uint64, error) {
+	if l1Header.Time < genesisL2Time {
 		return 0, fmt.Errorf("l1 height precedes l2 genesis time")
 	}
 
@@ -136,16 +360,54 @@ func latestL2HeightFromL1Header(l2Cfg *registry.ChainConfig, l2Client *ethclient
 		return 0, fmt.Errorf("failed to query latest header: %w", err)
 	}
 
+	if blockTime == 0 {
+		return 0, fmt.Errorf("no block time configured")
+	}
+
 	// We can compute the number of blocks to wind back as the as the block time is fixed.
 	blockNum := latestHeader.Number.Uint64()
 	if latestHeader.Time > l1Header.Time {
 		timeDiff := latestHeader.Time - l1Header.Time
 		blocksToWalkBack := timeDiff / blockTime
-		if timeDiff%2 != 0 {
+		if timeDiff%blockTime != 0 {
 			blocksToWalkBack++
 		}
+		if blocksToWalkBack > blockNum {
+			return 0, fmt.Errorf("l1 height precedes l2 genesis block, walking back %d blocks from %d", blocksToWalkBack, blockNum)
+		}
 		blockNum = blockNum - blocksToWalkBack
 	}
 
 	return blockNum, nil
 }
+
+// applyGenesisToFork applies the genesis allocs of the chain on top of its forked state. Only contract
+// accounts are applied and any account which already has code in the forked state is left untouched.
+func applyGenesisToFork(ctx context.Context, chain config.Chain) error {
+	var genesisJSON genesis.GenesisJson
+	if err := json.Unmarshal(chain.Config().GenesisJSON, &genesisJSON); err != nil {
+		return fmt.Errorf("failed to unmarshal genesis: %w", err)
+	}
+
+	client := chain.EthClient()
+	for addr, alloc := range genesisJSON.Alloc {
+		if alloc.Code == "" || alloc.Code == "0x" {
+			continue
+		}
+
+		address := common.HexToAddress(addr)
+		code, err := client.CodeAt(ctx, address, nil)
+		if err != nil {
+			return fmt.Errorf("failed to fetch code for %s: %w", address, err)
+		}
+		if len(code) > 0 {
+			continue
+		}
+
+		if err := interop.ApplyAllocToAddress(ctx, chain, &alloc, address); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
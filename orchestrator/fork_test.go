@@ -0,0 +1,54 @@
+package orchestrator
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+// mockHeaderReader serves headers starting at `genesisTime` and incrementing by `blockTime`
+type mockHeaderReader struct {
+	genesisTime uint64
+	blockTime   uint64
+	latest      uint64
+}
+
+func (m *mockHeaderReader) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	blockNum := m.latest
+	if number != nil {
+		blockNum = number.Uint64()
+	}
+	return &types.Header{Number: new(big.Int).SetUint64(blockNum), Time: m.genesisTime + blockNum*m.blockTime}, nil
+}
+
+func TestBlockNumberAtTimestamp(t *testing.T) {
+	client := &mockHeaderReader{genesisTime: 1000, blockTime: 2, latest: 1000}
+	ctx := context.Background()
+
+	tests := []struct {
+		timestamp uint64
+		expected  uint64
+	}{
+		{timestamp: 1000, expected: 0},
+		{timestamp: 1001, expected: 0},
+		{timestamp: 1002, expected: 1},
+		{timestamp: 1501, expected: 250},
+		{timestamp: 2998, expected: 999},
+		{timestamp: 3000, expected: 1000},
+		{timestamp: 5000, expected: 1000},
+	}
+
+	for _, tt := range tests {
+		blockNum, err := blockNumberAtTimestamp(ctx, client, tt.timestamp)
+		require.NoError(t, err)
+		require.Equal(t, tt.expected, blockNum, "timestamp %d", tt.timestamp)
+	}
+
+	// precedes genesis
+	_, err := blockNumberAtTimestamp(ctx, client, 999)
+	require.Error(t, err)
+}
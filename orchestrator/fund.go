@@ -0,0 +1,160 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum-optimism/supersim/config"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// Number of storage slots searched for the balances mapping of an ERC20
+	maxBalanceSlot = 100
+
+	// Decimals of ETH balances
+	ethDecimals = 18
+)
+
+var (
+	decimalsSelector  = crypto.Keccak256([]byte("decimals()"))[:4]
+	balanceOfSelector = crypto.Keccak256([]byte("balanceOf(address)"))[:4]
+
+	// Seed of the balance slots of solady's ERC20
+	soladyBalanceSlotSeed = common.FromHex("0x87a211a2")
+
+	// Arbitrary balance written while searching for the balances mapping
+	probeBalance = common.HexToHash("0x5ebe5ebe5ebe5ebe5ebe5ebe5ebe5ebe5ebe5ebe5ebe5ebe5ebe5ebe5ebe5ebe")
+)
+
+// fundAccounts applies the funded accounts of each chain's config
+func (o *Orchestrator) fundAccounts(ctx context.Context, chains []config.Chain) error {
+	return forEachChain(chains, func(_ int, chain config.Chain) error {
+		for _, account := range chain.Config().FundedAccounts {
+			if err := fundAccount(ctx, chain, account); err != nil {
+				return fmt.Errorf("failed to fund %s on chain %s: %w", account.Address, chain.Config().Name, err)
+			}
+		}
+		return nil
+	})
+}
+
+// Fund sets the balance of the account on the specified chains, or every chain when none are specified
+func (o *Orchestrator) Fund(ctx context.Context, account config.FundedAccount, chainIDs []uint64) error {
+	chains := o.allChains()
+	if len(chainIDs) > 0 {
+		chainByID := make(map[uint64]config.Chain, len(chains))
+		for _, chain := range chains {
+			chainByID[chain.Config().ChainID] = chain
+		}
+
+		chains = nil
+		for _, chainID := range chainIDs {
+			chain, ok := chainByID[chainID]
+			if !ok {
+				return fmt.Errorf("unknown chain %d", chainID)
+			}
+			chains = append(chains, chain)
+		}
+	}
+
+	err := forEachChain(chains, func(_ int, chain config.Chain) error {
+		if err := fundAccount(ctx, chain, account); err != nil {
+			return fmt.Errorf("failed to fund %s on chain %s: %w", account.Address, chain.Config().Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	o.log.Info("funded account", "address", account.Address, "amount", account.Amount, "token", account.Token)
+	return nil
+}
+
+func fundAccount(ctx context.Context, chain config.Chain, account config.FundedAccount) error {
+	if account.Token == nil {
+		balance, err := account.BaseUnits(ethDecimals)
+		if err != nil {
+			return err
+		}
+		return chain.SetBalance(ctx, nil, account.Address, balance)
+	}
+
+	decimals, err := callUint(ctx, chain, *account.Token, decimalsSelector)
+	if err != nil {
+		return fmt.Errorf("failed to fetch decimals of token %s: %w", account.Token, err)
+	}
+	if !decimals.IsUint64() || decimals.Uint64() > 255 {
+		return fmt.Errorf("invalid decimals %s of token %s", decimals, account.Token)
+	}
+
+	balance, err := account.BaseUnits(uint8(decimals.Uint64()))
+	if err != nil {
+		return err
+	}
+
+	slot, err := findBalanceSlot(ctx, chain, *account.Token, account.Address)
+	if err != nil {
+		return err
+	}
+	return chain.SetStorageAt(ctx, nil, *account.Token, slot.Hex(), common.BigToHash(balance).Hex())
+}
+
+// findBalanceSlot searches for the storage slot of the holder's balance, by writing a probe value to each
+// candidate slot and checking it is returned by `balanceOf`
+func findBalanceSlot(ctx context.Context, chain config.Chain, token common.Address, holder common.Address) (common.Hash, error) {
+	balanceOfData := append(append([]byte{}, balanceOfSelector...), common.LeftPadBytes(holder.Bytes(), 32)...)
+
+	for _, slot := range balanceSlotCandidates(holder) {
+		original, err := chain.EthClient().StorageAt(ctx, token, slot, nil)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("failed to read storage of token %s: %w", token, err)
+		}
+		if err := chain.SetStorageAt(ctx, nil, token, slot.Hex(), probeBalance.Hex()); err != nil {
+			return common.Hash{}, fmt.Errorf("failed to write storage of token %s: %w", token, err)
+		}
+
+		balance, err := callUint(ctx, chain, token, balanceOfData)
+		if err == nil && common.BigToHash(balance) == probeBalance {
+			return slot, nil
+		}
+
+		if err := chain.SetStorageAt(ctx, nil, token, slot.Hex(), common.BytesToHash(original).Hex()); err != nil {
+			return common.Hash{}, fmt.Errorf("failed to restore storage of token %s: %w", token, err)
+		}
+	}
+
+	return common.Hash{}, fmt.Errorf("balances mapping of token %s not found", token)
+}
+
+// balanceSlotCandidates returns the possible slots of the holder's balance. The solady layout, used by the
+// SuperchainERC20 tokens, is followed by the solidity, keccak(holder . slot), and vyper, keccak(slot . holder),
+// layouts of a balances mapping in any of the first `maxBalanceSlot` slots.
+func balanceSlotCandidates(holder common.Address) []common.Hash {
+	paddedHolder := common.LeftPadBytes(holder.Bytes(), 32)
+	candidates := []common.Hash{
+		crypto.Keccak256Hash(holder.Bytes(), make([]byte, 8), soladyBalanceSlotSeed),
+	}
+
+	for i := uint64(0); i < maxBalanceSlot; i++ {
+		mappingSlot := common.BigToHash(new(big.Int).SetUint64(i)).Bytes()
+		candidates = append(candidates, crypto.Keccak256Hash(paddedHolder, mappingSlot), crypto.Keccak256Hash(mappingSlot, paddedHolder))
+	}
+	return candidates
+}
+
+func callUint(ctx context.Context, chain config.Chain, to common.Address, data []byte) (*big.Int, error) {
+	result, err := chain.EthClient().CallContract(ctx, ethereum.CallMsg{To: &to, Data: data}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) < 32 {
+		return nil, fmt.Errorf("unexpected return data of %d bytes", len(result))
+	}
+	return new(big.Int).SetBytes(result[:32]), nil
+}
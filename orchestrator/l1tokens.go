@@ -0,0 +1,205 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	opbindings "github.com/ethereum-optimism/optimism/op-e2e/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/supersim/bindings"
+	"github.com/ethereum-optimism/supersim/config"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// L1TokenDeployment is an ERC20 deployed to the L1, along with the OptimismMintableERC20 created for it on
+// every L2 such that it can be deposited through the L1StandardBridge
+type L1TokenDeployment struct {
+	Name     string         `json:"name"`
+	Symbol   string         `json:"symbol"`
+	Decimals uint8          `json:"decimals"`
+	Address  common.Address `json:"address"`
+
+	L2Tokens []*ChainDeployment `json:"l2Tokens"`
+}
+
+// L2Token returns the address of the OptimismMintableERC20 on the chain
+func (d *L1TokenDeployment) L2Token(chainID uint64) (common.Address, bool) {
+	for _, l2Token := range d.L2Tokens {
+		if l2Token.ChainID == chainID {
+			return l2Token.Address, true
+		}
+	}
+	return common.Address{}, false
+}
+
+// DeployL1Token deploys an openly mintable ERC20 to the L1, minting its balances, and creates its
+// OptimismMintableERC20 on every L2 through the OptimismMintableERC20Factory. Tokens already deployed are
+// only minted
+func (o *Orchestrator) DeployL1Token(ctx context.Context, token config.Token) (*L1TokenDeployment, error) {
+	if err := token.Check(); err != nil {
+		return nil, err
+	}
+
+	initCode, err := l1TokenInitCode(token.Name, token.Symbol, token.Decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	deployer := o.l1Chain.Config().SecretsConfig.DevAccounts()[0].Address
+	l1Token, _, err := deployCreate2(ctx, o.l1Chain, deployer, common.Hash{}, initCode)
+	if err != nil {
+		return nil, fmt.Errorf("failed to deploy %s on the L1: %w", token.Symbol, err)
+	}
+	for _, balance := range token.Balances {
+		if err := mintToken(ctx, o.l1Chain, deployer, l1Token, bindings.MintableERC20ParsedABI, balance, token.Decimals); err != nil {
+			return nil, fmt.Errorf("failed to mint %s on the L1: %w", token.Symbol, err)
+		}
+	}
+
+	var chains []config.Chain
+	for _, opSim := range o.sortedOpSims() {
+		chains = append(chains, opSim)
+	}
+
+	l2Tokens := make([]*ChainDeployment, len(chains))
+	err = forEachChain(chains, func(i int, chain config.Chain) error {
+		// Tokens created before the fork are not looked up
+		fromBlock, _ := o.forkHeight(chain.Config().ChainID)
+		addr, txHash, err := createOptimismMintableERC20(ctx, chain, deployer, l1Token, token, fromBlock)
+		if err != nil {
+			return fmt.Errorf("failed to create the OptimismMintableERC20 of %s on chain %s: %w", token.Symbol, chain.Config().Name, err)
+		}
+		l2Tokens[i] = &ChainDeployment{ChainID: chain.Config().ChainID, Address: addr, TxHash: txHash}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	deployment := &L1TokenDeployment{Name: token.Name, Symbol: token.Symbol, Decimals: token.Decimals, Address: l1Token, L2Tokens: l2Tokens}
+	o.l1TokensMu.Lock()
+	defer o.l1TokensMu.Unlock()
+	for _, existing := range o.l1Tokens {
+		if existing.Address == deployment.Address {
+			return deployment, nil
+		}
+	}
+	o.l1Tokens = append(o.l1Tokens, deployment)
+
+	o.log.Info("deployed L1 token", "name", token.Name, "symbol", token.Symbol, "address", deployment.Address)
+	return deployment, nil
+}
+
+// L1Tokens returns the deployed L1 tokens, in order of deployment
+func (o *Orchestrator) L1Tokens() []*L1TokenDeployment {
+	o.l1TokensMu.Lock()
+	defer o.l1TokensMu.Unlock()
+	return append([]*L1TokenDeployment{}, o.l1Tokens...)
+}
+
+func (o *Orchestrator) deployL1Tokens(ctx context.Context) error {
+	for _, token := range o.config.L1Tokens {
+		if _, err := o.DeployL1Token(ctx, token); err != nil {
+			return fmt.Errorf("failed to deploy L1 token %s: %w", token.Symbol, err)
+		}
+	}
+	return nil
+}
+
+// l1TokenInitCode returns the creation code of a MintableERC20, an openly mintable token
+func l1TokenInitCode(name, symbol string, decimals uint8) ([]byte, error) {
+	args, err := bindings.MintableERC20ParsedABI.Pack("", name, symbol, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack constructor arguments: %w", err)
+	}
+	return append(common.FromHex(bindings.MintableERC20MetaData.Bin), args...), nil
+}
+
+// createOptimismMintableERC20 creates the L2 token of the remote L1 token through the factory predeploy from the
+// impersonated sender. The transaction hash is empty when the token was already created, as looked up from the block
+func createOptimismMintableERC20(ctx context.Context, chain config.Chain, from, remoteToken common.Address, token config.Token, fromBlock uint64) (common.Address, common.Hash, error) {
+	factoryABI, err := opbindings.OptimismMintableERC20FactoryMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, common.Hash{}, err
+	}
+	data, err := factoryABI.Pack("createOptimismMintableERC20WithDecimals", remoteToken, token.Name, token.Symbol, token.Decimals)
+	if err != nil {
+		return common.Address{}, common.Hash{}, fmt.Errorf("failed to pack createOptimismMintableERC20WithDecimals: %w", err)
+	}
+
+	// The factory creates the token with CREATE2, such that the creation reverts once created
+	factory := predeploys.OptimismMintableERC20FactoryAddr
+	ret, err := chain.EthClient().CallContract(ctx, ethereum.CallMsg{From: from, To: &factory, Data: data}, nil)
+	if err != nil {
+		addr, found, findErr := findOptimismMintableERC20(ctx, chain, remoteToken, token, fromBlock)
+		if findErr != nil {
+			return common.Address{}, common.Hash{}, findErr
+		}
+		if !found {
+			return common.Address{}, common.Hash{}, fmt.Errorf("failed to simulate the creation: %w", err)
+		}
+		return addr, common.Hash{}, nil
+	}
+
+	out, err := factoryABI.Unpack("createOptimismMintableERC20WithDecimals", ret)
+	if err != nil {
+		return common.Address{}, common.Hash{}, fmt.Errorf("failed to unpack the created address: %w", err)
+	}
+	addr := out[0].(common.Address)
+
+	stopImpersonating, err := impersonate(ctx, chain, from)
+	if err != nil {
+		return common.Address{}, common.Hash{}, err
+	}
+	defer func() { _ = stopImpersonating() }()
+
+	receipt, err := sendAsImpersonated(ctx, chain, from, factory, nil, data)
+	if err != nil {
+		return common.Address{}, common.Hash{}, err
+	}
+	return addr, receipt.TxHash, nil
+}
+
+// findOptimismMintableERC20 looks up an OptimismMintableERC20 of the remote token, with the same name, symbol & decimals,
+// created by the factory predeploy since the block
+func findOptimismMintableERC20(ctx context.Context, chain config.Chain, remoteToken common.Address, token config.Token, fromBlock uint64) (common.Address, bool, error) {
+	factory, err := opbindings.NewOptimismMintableERC20Factory(predeploys.OptimismMintableERC20FactoryAddr, chain.EthClient())
+	if err != nil {
+		return common.Address{}, false, fmt.Errorf("failed to bind OptimismMintableERC20Factory: %w", err)
+	}
+
+	opts := &bind.FilterOpts{Context: ctx, Start: fromBlock}
+	events, err := factory.FilterOptimismMintableERC20Created(opts, nil, []common.Address{remoteToken})
+	if err != nil {
+		return common.Address{}, false, fmt.Errorf("failed to filter OptimismMintableERC20Created events: %w", err)
+	}
+	defer events.Close()
+
+	for events.Next() {
+		l2Token, err := opbindings.NewOptimismMintableERC20Caller(events.Event.LocalToken, chain.EthClient())
+		if err != nil {
+			return common.Address{}, false, fmt.Errorf("failed to bind OptimismMintableERC20: %w", err)
+		}
+
+		callOpts := &bind.CallOpts{Context: ctx}
+		name, err := l2Token.Name(callOpts)
+		if err != nil {
+			return common.Address{}, false, fmt.Errorf("failed to fetch name of %s: %w", events.Event.LocalToken, err)
+		}
+		symbol, err := l2Token.Symbol(callOpts)
+		if err != nil {
+			return common.Address{}, false, fmt.Errorf("failed to fetch symbol of %s: %w", events.Event.LocalToken, err)
+		}
+		decimals, err := l2Token.Decimals(callOpts)
+		if err != nil {
+			return common.Address{}, false, fmt.Errorf("failed to fetch decimals of %s: %w", events.Event.LocalToken, err)
+		}
+		if name == token.Name && symbol == token.Symbol && decimals == token.Decimals {
+			return events.Event.LocalToken, true, nil
+		}
+	}
+	return common.Address{}, false, events.Error()
+}
@@ -0,0 +1,35 @@
+package orchestrator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/supersim/bindings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestL1TokenDeployment(t *testing.T) {
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	cfg := &runtime.Config{State: statedb, GasLimit: 10_000_000}
+
+	// standalone, without any L2 predeploy on the chain
+	initCode, err := l1TokenInitCode("Test Token", "TEST", 6)
+	require.NoError(t, err)
+	_, token, _, err := runtime.Create(initCode, cfg)
+	require.NoError(t, err)
+
+	tokenABI := bindings.MintableERC20ParsedABI
+	require.Equal(t, "Test Token", callToken(t, cfg, tokenABI, token, "name")[0])
+	require.Equal(t, "TEST", callToken(t, cfg, tokenABI, token, "symbol")[0])
+	require.Equal(t, uint8(6), callToken(t, cfg, tokenABI, token, "decimals")[0])
+
+	account := common.HexToAddress("0xbeef")
+	callToken(t, cfg, tokenABI, token, "mint", account, big.NewInt(100))
+	require.Equal(t, big.NewInt(100), callToken(t, cfg, tokenABI, token, "balanceOf", account)[0])
+}
@@ -5,19 +5,25 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
-	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ethereum-optimism/supersim/anvil"
 	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/crossdomain"
+	"github.com/ethereum-optimism/supersim/genesis"
 	"github.com/ethereum-optimism/supersim/interop"
 	opsimulator "github.com/ethereum-optimism/supersim/opsimulator"
+	"github.com/ethereum-optimism/supersim/proposer"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/log"
 )
 
+var errInteropDisabled = errors.New("interop is not enabled")
+
 type Orchestrator struct {
 	log    log.Logger
 	config *config.NetworkConfig
@@ -29,6 +35,25 @@ type Orchestrator struct {
 
 	l2ToL2MsgIndexer *interop.L2ToL2MessageIndexer
 	l2ToL2MsgRelayer *interop.L2ToL2MessageRelayer
+
+	crossDomainMsgIndexer *crossdomain.MessageIndexer
+
+	l2OutputProposer *proposer.L2OutputProposer
+
+	superchainERC20sMu sync.Mutex
+	superchainERC20s   []*SuperchainERC20Deployment
+
+	l1TokensMu sync.Mutex
+	l1Tokens   []*L1TokenDeployment
+
+	snapshotsMu    sync.Mutex
+	snapshots      map[uint64]map[uint64]string
+	nextSnapshotID uint64
+
+	// Heights of the forked chains by chain id, moved by a re-fork. The fork config
+	// of a chain is left as configured, holding the height the network started at
+	forkHeightsMu sync.Mutex
+	forkHeights   map[uint64]uint64
 }
 
 func NewOrchestrator(log log.Logger, closeApp context.CancelCauseFunc, networkConfig *config.NetworkConfig) (*Orchestrator, error) {
@@ -57,7 +82,13 @@ func NewOrchestrator(log log.Logger, closeApp context.CancelCauseFunc, networkCo
 		}
 	}
 
-	o := Orchestrator{log: log, config: networkConfig, l1Chain: l1Anvil, l2Chains: l2Anvils, l2OpSims: l2OpSims}
+	o := Orchestrator{log: log, config: networkConfig, l1Chain: l1Anvil, l2Chains: l2Anvils, l2OpSims: l2OpSims, snapshots: make(map[uint64]map[uint64]string), forkHeights: make(map[uint64]uint64)}
+	for _, cfg := range append([]config.ChainConfig{networkConfig.L1Config}, networkConfig.L2Configs...) {
+		if cfg.ForkConfig != nil {
+			o.forkHeights[cfg.ChainID] = cfg.ForkConfig.BlockNumber
+		}
+	}
+	o.crossDomainMsgIndexer = crossdomain.NewMessageIndexer(log)
 
 	// Interop Setup
 	if networkConfig.InteropEnabled {
@@ -67,6 +98,25 @@ func NewOrchestrator(log log.Logger, closeApp context.CancelCauseFunc, networkCo
 		}
 	}
 
+	if networkConfig.ProposerInterval > 0 {
+		o.l2OutputProposer = proposer.NewL2OutputProposer(log, time.Duration(networkConfig.ProposerInterval)*time.Second)
+
+		// Only the genesis accounts with code are applied to a forked L1, the proposers of the local L2s are funded
+		// for the bonds of their games
+		if networkConfig.L1Config.ForkConfig != nil {
+			for _, cfg := range networkConfig.L2Configs {
+				if cfg.ForkConfig != nil {
+					continue
+				}
+				proposerAddr, err := proposer.Address(cfg.ChainID)
+				if err != nil {
+					return nil, fmt.Errorf("failed to derive the proposer of chain %s: %w", cfg.Name, err)
+				}
+				networkConfig.L1Config.FundedAccounts = append(networkConfig.L1Config.FundedAccounts, config.FundedAccount{Address: proposerAddr, Amount: config.DefaultDevAccountBalance})
+			}
+		}
+	}
+
 	return &o, nil
 }
 
@@ -77,6 +127,14 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 	if err := o.l1Chain.Start(ctx); err != nil {
 		return fmt.Errorf("l1 chain %s failed to start: %w", o.l1Chain.Config().Name, err)
 	}
+
+	// A forked L1 with a genesis contains the L1 deployments of local L2s
+	if l1Cfg := o.l1Chain.Config(); l1Cfg.ForkConfig != nil && len(l1Cfg.GenesisJSON) > 0 {
+		o.log.Info("applying local l1 deployments to forked l1 chain")
+		if err := applyGenesisToFork(ctx, o.l1Chain); err != nil {
+			return fmt.Errorf("failed to apply genesis to forked l1 chain %s: %w", l1Cfg.Name, err)
+		}
+	}
 	for _, chain := range o.l2Chains {
 		if err := chain.Start(ctx); err != nil {
 			return fmt.Errorf("l2 chain %s failed to start: %w", chain.Config().Name, err)
@@ -93,6 +151,10 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 		return fmt.Errorf("unable to start mining: %w", err)
 	}
 
+	if err := o.fundAccounts(ctx, o.allChains()); err != nil {
+		return fmt.Errorf("unable to fund accounts: %w", err)
+	}
+
 	// TODO: hack until opsim proxy supports websocket connections.
 	// We need websocket connections to make subscriptions.
 	// We should try to use make RPC through opsim not directly to the underlying chain
@@ -103,6 +165,20 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 		l2OpSimClientByChainId[chainID] = opSim.EthClient()
 	}
 
+	l1MessengerByAddress := make(map[common.Address]uint64)
+	indexedL2Chains := make([]crossdomain.IndexedChain, 0, len(o.l2OpSims))
+	for _, opSim := range o.sortedOpSims() {
+		cfg := opSim.Config()
+		if addresses := cfg.L2Config.L1Addresses; addresses != nil {
+			l1MessengerByAddress[common.Address(addresses.L1CrossDomainMessengerProxy)] = cfg.ChainID
+		}
+		indexedL2Chains = append(indexedL2Chains, crossdomain.IndexedChain{ChainID: cfg.ChainID, Client: opSim.Chain.EthClient(), StartBlock: o.indexStartBlock(cfg.ChainID)})
+	}
+	indexedL1Chain := crossdomain.IndexedChain{ChainID: o.l1Chain.Config().ChainID, Client: o.l1Chain.EthClient(), StartBlock: o.indexStartBlock(o.l1Chain.Config().ChainID)}
+	if err := o.crossDomainMsgIndexer.Start(ctx, indexedL1Chain, l1MessengerByAddress, indexedL2Chains); err != nil {
+		return fmt.Errorf("cross domain message indexer failed to start: %w", err)
+	}
+
 	// Configure Interop (if applicable)
 	if o.config.InteropEnabled {
 		o.log.Info("configuring interop contracts")
@@ -137,6 +213,30 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 		}
 	}
 
+	if err := o.deploySuperchainERC20s(ctx); err != nil {
+		return err
+	}
+	if err := o.deployL1Tokens(ctx); err != nil {
+		return err
+	}
+
+	// Only the local L2s are proposed, forked chains settle to their remote L1
+	if o.l2OutputProposer != nil {
+		var localL2Chains []config.Chain
+		for _, chain := range o.l2Chains {
+			if chain.Config().ForkConfig == nil {
+				localL2Chains = append(localL2Chains, chain)
+			}
+		}
+
+		if len(localL2Chains) > 0 {
+			o.log.Debug("starting l2 output proposer")
+			if err := o.l2OutputProposer.Start(o.l1Chain.EthClient(), localL2Chains); err != nil {
+				return fmt.Errorf("l2 output proposer failed to start: %w", err)
+			}
+		}
+	}
+
 	o.log.Debug("orchestrator is ready")
 	return nil
 }
@@ -144,6 +244,11 @@ func (o *Orchestrator) Start(ctx context.Context) error {
 func (o *Orchestrator) Stop(ctx context.Context) error {
 	var errs []error
 	o.log.Debug("stopping orchestrator")
+	if o.l2OutputProposer != nil {
+		o.log.Debug("stopping l2 output proposer")
+		o.l2OutputProposer.Stop(ctx)
+	}
+
 	if o.config.InteropEnabled {
 		if o.l2ToL2MsgRelayer != nil {
 			o.log.Info("stopping L2ToL2CrossDomainMessenger autorelayer")
@@ -156,6 +261,11 @@ func (o *Orchestrator) Stop(ctx context.Context) error {
 		}
 	}
 
+	o.log.Debug("stopping CrossDomainMessenger indexer")
+	if err := o.crossDomainMsgIndexer.Stop(ctx); err != nil {
+		errs = append(errs, fmt.Errorf("cross domain message indexer failed to stop: %w", err))
+	}
+
 	for _, opSim := range o.l2OpSims {
 		o.log.Debug("stopping op simulator", "chain.id", opSim.Config().ChainID)
 		if err := opSim.Stop(ctx); err != nil {
@@ -178,27 +288,65 @@ func (o *Orchestrator) Stop(ctx context.Context) error {
 }
 
 func (o *Orchestrator) kickOffMining(ctx context.Context) error {
-	if err := o.l1Chain.SetIntervalMining(ctx, nil, 2); err != nil {
-		return errors.New("failed to start interval mining on l1")
-	}
+	chains := o.allChains()
 
 	var wg sync.WaitGroup
-	wg.Add(len(o.l2Chains))
+	wg.Add(len(chains))
 
-	errs := make([]error, len(o.l2Chains))
-	for i, chain := range o.L2Chains() {
+	errs := make([]error, len(chains))
+	for i, chain := range chains {
 		go func(i int) {
-			if err := chain.SetIntervalMining(ctx, nil, 2); err != nil {
-				errs[i] = fmt.Errorf("failed to start interval mining for chain %s", chain.Config().Name)
+			if err := startMining(ctx, chain); err != nil {
+				errs[i] = fmt.Errorf("failed to start mining for chain %s: %w", chain.Config().Name, err)
 			}
 
 			wg.Done()
 		}(i)
 	}
 
+	wg.Wait()
 	return errors.Join(errs...)
 }
 
+// forkHeight returns the height the chain is currently forked at, false when the chain is local
+func (o *Orchestrator) forkHeight(chainID uint64) (uint64, bool) {
+	o.forkHeightsMu.Lock()
+	defer o.forkHeightsMu.Unlock()
+	height, ok := o.forkHeights[chainID]
+	return height, ok
+}
+
+// indexStartBlock is the first block of the chain to index messages from, its genesis when
+// local or the block after the fork
+func (o *Orchestrator) indexStartBlock(chainID uint64) uint64 {
+	if height, ok := o.forkHeight(chainID); ok {
+		return height + 1
+	}
+	return 0
+}
+
+// chainBlockTime is the time in seconds between the blocks of the chain
+func chainBlockTime(cfg *config.ChainConfig) uint64 {
+	if cfg.BlockTime == 0 {
+		return config.DefaultBlockTime
+	}
+	return cfg.BlockTime
+}
+
+func startMining(ctx context.Context, chain config.Chain) error {
+	cfg := chain.Config()
+	switch cfg.MiningMode {
+	case config.AutomineMiningMode:
+		return chain.SetAutomine(ctx, nil, true)
+	case config.ManualMiningMode:
+		return chain.SetAutomine(ctx, nil, false)
+	case config.IntervalMiningMode, "":
+		return chain.SetIntervalMining(ctx, nil, int64(chainBlockTime(cfg)))
+	default:
+		return fmt.Errorf("unrecognized mining mode: %s", cfg.MiningMode)
+	}
+}
+
 func (o *Orchestrator) L1Chain() config.Chain {
 	return o.l1Chain
 }
@@ -211,6 +359,27 @@ func (o *Orchestrator) L2Chains() []config.Chain {
 	return chains
 }
 
+// Manifest returns the deployments of the genesis the local chains run from, nil in fork mode
+func (o *Orchestrator) Manifest() *genesis.Manifest {
+	return o.config.Manifest
+}
+
+// GetL2ToL2Message returns the indexed state of an interop message, nil if the message has not been indexed
+func (o *Orchestrator) GetL2ToL2Message(ctx context.Context, msgHash common.Hash) (*interop.L2ToL2MessageDocument, error) {
+	if o.l2ToL2MsgIndexer == nil {
+		return nil, errInteropDisabled
+	}
+	return o.l2ToL2MsgIndexer.GetL2ToL2Message(ctx, msgHash)
+}
+
+// ListL2ToL2Messages returns every indexed interop message, ordered by source chain and nonce
+func (o *Orchestrator) ListL2ToL2Messages(ctx context.Context) ([]*interop.L2ToL2MessageDocument, error) {
+	if o.l2ToL2MsgIndexer == nil {
+		return nil, errInteropDisabled
+	}
+	return o.l2ToL2MsgIndexer.ListL2ToL2Messages(ctx)
+}
+
 func (o *Orchestrator) Endpoint(chainId uint64) string {
 	if o.l1Chain.Config().ChainID == chainId {
 		return o.l1Chain.Endpoint()
@@ -224,14 +393,7 @@ func (o *Orchestrator) ConfigAsString() string {
 	fmt.Fprintf(&b, "L1: Name: %s  ChainID: %d  RPC: %s  LogPath: %s\n", l1Cfg.Name, l1Cfg.ChainID, o.l1Chain.Endpoint(), o.l1Chain.LogPath())
 
 	fmt.Fprintf(&b, "\nL2: Predeploy Contracts Spec ( %s )\n", "https://specs.optimism.io/protocol/predeploys.html")
-	opSims := make([]*opsimulator.OpSimulator, 0, len(o.l2OpSims))
-	for _, chain := range o.l2OpSims {
-		opSims = append(opSims, chain)
-	}
-
-	// sort by port number (retain ordering of chain flags)
-	sort.Slice(opSims, func(i, j int) bool { return opSims[i].Config().Port < opSims[j].Config().Port })
-	for _, opSim := range opSims {
+	for _, opSim := range o.sortedOpSims() {
 		cfg := opSim.Config()
 		fmt.Fprintf(&b, "\n")
 		fmt.Fprintf(&b, "  * Name: %s  ChainID: %d  RPC: %s  LogPath: %s\n", cfg.Name, cfg.ChainID, opSim.Endpoint(), opSim.LogPath())
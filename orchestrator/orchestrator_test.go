@@ -10,6 +10,7 @@ import (
 
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 
 	"github.com/stretchr/testify/require"
@@ -62,3 +63,44 @@ func TestStartup(t *testing.T) {
 	require.NotNil(t, chains[1].Config().L2Config)
 	require.Equal(t, chains[1].Config().L2Config.L1ChainID, uint64(900))
 }
+
+func TestAdvanceTime(t *testing.T) {
+	testSuite := createTestSuite(t)
+	ctx := context.Background()
+
+	chains := append([]config.Chain{testSuite.orchestrator.L1Chain()}, testSuite.orchestrator.L2Chains()...)
+	before := make([]*types.Header, len(chains))
+	for i, chain := range chains {
+		header, err := chain.EthClient().HeaderByNumber(ctx, nil)
+		require.NoError(t, err)
+		before[i] = header
+	}
+
+	// one hour
+	seconds := uint64(60 * 60)
+	timestamp, err := testSuite.orchestrator.AdvanceTime(ctx, seconds)
+	require.NoError(t, err)
+
+	// every chain mines a block each block time until it reaches the timestamp
+	for i, chain := range chains {
+		header, err := chain.EthClient().HeaderByNumber(ctx, nil)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, header.Time, timestamp)
+
+		blockTime := chainBlockTime(chain.Config())
+		blocks := (timestamp - before[i].Time + blockTime - 1) / blockTime
+		require.Equal(t, before[i].Number.Uint64()+blocks, header.Number.Uint64())
+		require.Equal(t, before[i].Time+blocks*blockTime, header.Time)
+	}
+
+	// cannot move backwards in time
+	require.Error(t, testSuite.orchestrator.SetTime(ctx, timestamp-seconds))
+	require.NoError(t, testSuite.orchestrator.SetTime(ctx, timestamp+seconds))
+}
+
+func TestReforkRequiresFork(t *testing.T) {
+	testSuite := createTestSuite(t)
+
+	_, err := testSuite.orchestrator.Refork(context.Background(), &ReforkRequest{})
+	require.Error(t, err)
+}
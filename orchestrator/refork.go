@@ -0,0 +1,241 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+
+	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/interop"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// ReforkRequest describes the heights to re-fork the network at, the same as the fork heights on startup
+type ReforkRequest struct {
+	// L1 height, `0` for latest. Each L2 height is derived from the L1 block
+	L1BlockNumber uint64 `json:"l1BlockNumber"`
+
+	// Fork every chain at its latest block at or before the timestamp, instead of the L1 height
+	Timestamp uint64 `json:"timestamp"`
+
+	// L2 heights by chain name, instead of deriving them
+	L2BlockNumbers map[string]uint64 `json:"l2BlockNumbers,omitempty"`
+
+	// Re-send the transactions previously sent to the L2s
+	ReplayTxs bool `json:"replayTxs"`
+}
+
+// ReforkResult describes the fork heights of the network after a re-fork
+type ReforkResult struct {
+	L1BlockNumber uint64 `json:"l1BlockNumber"`
+
+	// L2 fork heights by chain id
+	L2BlockNumbers map[uint64]uint64 `json:"l2BlockNumbers"`
+
+	ReplayedTransactions int `json:"replayedTransactions"`
+	FailedTransactions   int `json:"failedTransactions"`
+}
+
+// Refork resets the forked L1 & L2s to the heights of the request. Networks with local L2s are not
+// re-forked, their state would be left attached to the previous L1.
+func (o *Orchestrator) Refork(ctx context.Context, req *ReforkRequest) (*ReforkResult, error) {
+	l1Cfg := o.l1Chain.Config()
+	if l1Cfg.ForkConfig == nil {
+		return nil, errors.New("network is not forked")
+	}
+	if req.Timestamp > 0 && req.L1BlockNumber > 0 {
+		return nil, errors.New("l1 block number and timestamp are mutually exclusive")
+	}
+
+	forkedL2Chains := o.forkedL2Chains()
+	if len(forkedL2Chains) != len(o.L2Chains()) {
+		return nil, errors.New("network has local l2 chains, which cannot be re-forked")
+	}
+	for name := range req.L2BlockNumbers {
+		if !slices.ContainsFunc(forkedL2Chains, func(chain config.Chain) bool { return chain.Config().Name == name }) {
+			return nil, fmt.Errorf("l2 block number specified for chain %s which is not forked", name)
+		}
+	}
+
+	l1Client, err := ethclient.DialContext(ctx, l1Cfg.ForkConfig.RPCUrl)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial l1 rpc: %w", err)
+	}
+	defer l1Client.Close()
+
+	var l1Height *big.Int
+	if req.Timestamp > 0 {
+		height, err := blockNumberAtTimestamp(ctx, l1Client, req.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find l1 height at timestamp %d: %w", req.Timestamp, err)
+		}
+		l1Height = new(big.Int).SetUint64(height)
+	} else if req.L1BlockNumber > 0 {
+		l1Height = new(big.Int).SetUint64(req.L1BlockNumber)
+	}
+	l1Header, err := l1Client.HeaderByNumber(ctx, l1Height)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve L1 header: %w", err)
+	}
+
+	// Resolve every height before resetting any chain such that a failure leaves the network untouched
+	l2Heights := make(map[uint64]uint64, len(forkedL2Chains))
+	for _, chain := range forkedL2Chains {
+		cfg := chain.Config()
+		var explicitHeight *uint64
+		if height, ok := req.L2BlockNumbers[cfg.Name]; ok {
+			explicitHeight = &height
+		}
+		height, err := reforkL2Height(ctx, cfg.ForkConfig, req.Timestamp, explicitHeight, l1Header)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find right l2 height for chain %s: %w", cfg.Name, err)
+		}
+		l2Heights[cfg.ChainID] = height
+	}
+
+	// Previously sent transactions are no longer part of the re-forked chains. They are only dropped from
+	// the record once every chain is reset, such that a failed re-fork can replay them later
+	sentTxs := make(map[uint64][]*types.Transaction)
+	for chainID, opSim := range o.l2OpSims {
+		for _, rawTx := range opSim.SentTransactions() {
+			tx := new(types.Transaction)
+			if err := tx.UnmarshalBinary(rawTx); err != nil {
+				return nil, fmt.Errorf("failed to decode sent transaction: %w", err)
+			}
+			sentTxs[chainID] = append(sentTxs[chainID], tx)
+		}
+	}
+
+	// Resetting the chains discards their snapshots. None are taken or reverted to while resetting
+	o.snapshotsMu.Lock()
+	defer o.snapshotsMu.Unlock()
+	clear(o.snapshots)
+
+	// L1
+	if err := o.l1Chain.Reset(ctx, nil, l1Cfg.ForkConfig.RPCUrl, l1Header.Number.Uint64()); err != nil {
+		return nil, fmt.Errorf("failed to reset l1 chain %s: %w", l1Cfg.Name, err)
+	}
+	o.setForkHeight(l1Cfg.ChainID, l1Header.Number.Uint64())
+	if len(l1Cfg.GenesisJSON) > 0 {
+		if err := applyGenesisToFork(ctx, o.l1Chain); err != nil {
+			return nil, fmt.Errorf("failed to apply genesis to forked l1 chain %s: %w", l1Cfg.Name, err)
+		}
+	}
+
+	// L2s
+	var wg sync.WaitGroup
+	wg.Add(len(forkedL2Chains))
+	errs := make([]error, len(forkedL2Chains))
+	for i, chain := range forkedL2Chains {
+		go func(i int) {
+			defer wg.Done()
+			cfg := chain.Config()
+			if err := chain.Reset(ctx, nil, cfg.ForkConfig.RPCUrl, l2Heights[cfg.ChainID]); err != nil {
+				errs[i] = fmt.Errorf("failed to reset l2 chain %s: %w", cfg.Name, err)
+				return
+			}
+			o.setForkHeight(cfg.ChainID, l2Heights[cfg.ChainID])
+
+			if o.config.InteropEnabled {
+				if err := interop.Configure(ctx, chain); err != nil {
+					errs[i] = fmt.Errorf("failed to configure interop for chain %s: %w", cfg.Name, err)
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	for chainID, txs := range sentTxs {
+		o.l2OpSims[chainID].DropSentTransactions(len(txs))
+	}
+
+	// Deposits & messages are followed from the block after the new fork heights, the blocks up to the
+	// fork are upstream history
+	for _, opSim := range o.l2OpSims {
+		opSim.RewindDeposits(o.indexStartBlock(l1Cfg.ChainID))
+	}
+	for _, chain := range o.allChains() {
+		if err := o.crossDomainMsgIndexer.Rewind(chain.Config().ChainID, o.indexStartBlock(chain.Config().ChainID)); err != nil {
+			return nil, fmt.Errorf("failed to rewind the message indexer: %w", err)
+		}
+	}
+
+	// Resetting a chain also resets its mining configuration
+	if err := o.kickOffMining(ctx); err != nil {
+		return nil, fmt.Errorf("unable to restart mining: %w", err)
+	}
+
+	// Funded balances are lost with the reset state
+	if err := o.fundAccounts(ctx, append([]config.Chain{o.l1Chain}, forkedL2Chains...)); err != nil {
+		return nil, fmt.Errorf("unable to fund accounts: %w", err)
+	}
+
+	// Tokens deployed at runtime are lost with the reset state. The configured tokens are redeployed, any
+	// other is no longer listed
+	o.superchainERC20sMu.Lock()
+	o.superchainERC20s = nil
+	o.superchainERC20sMu.Unlock()
+	o.l1TokensMu.Lock()
+	o.l1Tokens = nil
+	o.l1TokensMu.Unlock()
+	if err := o.deploySuperchainERC20s(ctx); err != nil {
+		return nil, err
+	}
+	if err := o.deployL1Tokens(ctx); err != nil {
+		return nil, err
+	}
+
+	result := &ReforkResult{L1BlockNumber: l1Header.Number.Uint64(), L2BlockNumbers: l2Heights}
+	if req.ReplayTxs {
+		for chainID, txs := range sentTxs {
+			opSim := o.l2OpSims[chainID]
+			for _, tx := range txs {
+				if err := opSim.EthClient().SendTransaction(ctx, tx); err != nil {
+					o.log.Warn("failed to replay transaction", "chain.id", chainID, "hash", tx.Hash(), "err", err)
+					result.FailedTransactions++
+					continue
+				}
+				result.ReplayedTransactions++
+			}
+		}
+	}
+
+	o.log.Info("re-forked network", "l1.fork.height", result.L1BlockNumber, "replayed.txs", result.ReplayedTransactions, "failed.txs", result.FailedTransactions)
+	return result, nil
+}
+
+// setForkHeight records the height the chain is re-forked at
+func (o *Orchestrator) setForkHeight(chainID, height uint64) {
+	o.forkHeightsMu.Lock()
+	defer o.forkHeightsMu.Unlock()
+	o.forkHeights[chainID] = height
+}
+
+// forkedL2Chains returns the L2s forked from a remote chain, excluding local chains
+func (o *Orchestrator) forkedL2Chains() []config.Chain {
+	var chains []config.Chain
+	for _, chain := range o.L2Chains() {
+		if chain.Config().ForkConfig != nil {
+			chains = append(chains, chain)
+		}
+	}
+	return chains
+}
+
+func reforkL2Height(ctx context.Context, forkConfig *config.ForkConfig, forkTimestamp uint64, explicitHeight *uint64, l1Header *types.Header) (uint64, error) {
+	l2Client, err := ethclient.DialContext(ctx, forkConfig.RPCUrl)
+	if err != nil {
+		return 0, fmt.Errorf("failed to dial l2 rpc: %w", err)
+	}
+	defer l2Client.Close()
+
+	return resolveL2ForkHeight(l2Client, forkTimestamp, explicitHeight, l1Header, forkConfig.GenesisL2Time, forkConfig.L2BlockTime)
+}
@@ -0,0 +1,45 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	opsimulator "github.com/ethereum-optimism/supersim/opsimulator"
+)
+
+// PauseSequencerRequest describes how an L2 handles user transactions while its sequencer is down
+type PauseSequencerRequest struct {
+	// `queue` (default) holds transactions until resumed, `reject` refuses them
+	Mode opsimulator.SequencerMode `json:"mode,omitempty"`
+
+	// L1 blocks after which the queued transactions are dropped, the superchain `seq_window_size` when zero
+	SequencingWindow uint64 `json:"sequencingWindow,omitempty"`
+}
+
+// PauseSequencer stops the inclusion of user transactions on the L2. Deposits through the OptimismPortal
+// are still included, such that transactions can be forced through the L1
+func (o *Orchestrator) PauseSequencer(ctx context.Context, chainID uint64, req *PauseSequencerRequest) error {
+	opSim, ok := o.l2OpSims[chainID]
+	if !ok {
+		return fmt.Errorf("unknown chain %d", chainID)
+	}
+	return opSim.PauseSequencer(ctx, req.Mode, req.SequencingWindow)
+}
+
+// ResumeSequencer includes the transactions queued on the L2 while paused, unless the sequencing window elapsed
+func (o *Orchestrator) ResumeSequencer(ctx context.Context, chainID uint64) (*opsimulator.SequencerResumeResult, error) {
+	opSim, ok := o.l2OpSims[chainID]
+	if !ok {
+		return nil, fmt.Errorf("unknown chain %d", chainID)
+	}
+	return opSim.ResumeSequencer(ctx)
+}
+
+// SequencerStatuses returns the sequencer status of every L2
+func (o *Orchestrator) SequencerStatuses() []*opsimulator.SequencerStatus {
+	var statuses []*opsimulator.SequencerStatus
+	for _, opSim := range o.sortedOpSims() {
+		statuses = append(statuses, opSim.SequencerStatus())
+	}
+	return statuses
+}
@@ -0,0 +1,107 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum-optimism/supersim/config"
+)
+
+// Snapshot stores the state of the L1 and every L2. Returns the id to revert the network to. The indexed
+// interop messages are not part of the snapshot
+func (o *Orchestrator) Snapshot(ctx context.Context) (uint64, error) {
+	o.snapshotsMu.Lock()
+	defer o.snapshotsMu.Unlock()
+
+	chains := o.allChains()
+	chainSnapshotIDs := make([]string, len(chains))
+	err := forEachChain(chains, func(i int, chain config.Chain) error {
+		if err := chain.Snapshot(ctx, &chainSnapshotIDs[i]); err != nil {
+			return fmt.Errorf("failed to snapshot chain %s: %w", chain.Config().Name, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	snapshotID := o.nextSnapshotID
+	o.nextSnapshotID++
+
+	o.snapshots[snapshotID] = make(map[uint64]string)
+	for i, chain := range chains {
+		o.snapshots[snapshotID][chain.Config().ChainID] = chainSnapshotIDs[i]
+	}
+
+	o.log.Info("snapshotted superchain", "id", snapshotID)
+	return snapshotID, nil
+}
+
+// Revert restores the L1 and every L2 to the snapshot. Like anvil, the snapshot and every snapshot taken
+// after it are discarded, a new snapshot must be taken to revert again. A chain that fails to revert leaves
+// the network partially reverted, the error names the chains that were reverted
+func (o *Orchestrator) Revert(ctx context.Context, snapshotID uint64) error {
+	o.snapshotsMu.Lock()
+	defer o.snapshotsMu.Unlock()
+
+	chainSnapshotIDs, ok := o.snapshots[snapshotID]
+	if !ok {
+		return fmt.Errorf("unknown snapshot %d", snapshotID)
+	}
+
+	chains := o.allChains()
+	reverted := make([]bool, len(chains))
+	err := forEachChain(chains, func(i int, chain config.Chain) error {
+		if err := chain.Revert(ctx, &reverted[i], chainSnapshotIDs[chain.Config().ChainID]); err != nil {
+			return fmt.Errorf("failed to revert chain %s: %w", chain.Config().Name, err)
+		}
+		if !reverted[i] {
+			return fmt.Errorf("snapshot of chain %s no longer exists", chain.Config().Name)
+		}
+		return nil
+	})
+
+	// The reverted chains have discarded their snapshots, so the network can't be reverted to it again
+	for id := range o.snapshots {
+		if id >= snapshotID {
+			delete(o.snapshots, id)
+		}
+	}
+	if err != nil {
+		var revertedChains []string
+		for i, chain := range chains {
+			if reverted[i] {
+				revertedChains = append(revertedChains, chain.Config().Name)
+			}
+		}
+		if len(revertedChains) == 0 {
+			return err
+		}
+
+		o.log.Error("partially reverted superchain", "id", snapshotID, "reverted", revertedChains, "err", err)
+		return fmt.Errorf("network partially reverted to snapshot %d, only chains %s were reverted: %w", snapshotID, strings.Join(revertedChains, ", "), err)
+	}
+
+	o.log.Info("reverted superchain", "id", snapshotID)
+	return nil
+}
+
+// forEachChain runs the function against every chain in parallel
+func forEachChain(chains []config.Chain, fn func(i int, chain config.Chain) error) error {
+	var wg sync.WaitGroup
+	wg.Add(len(chains))
+
+	errs := make([]error, len(chains))
+	for i, chain := range chains {
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = fn(i, chain)
+		}(i)
+	}
+
+	wg.Wait()
+	return errors.Join(errs...)
+}
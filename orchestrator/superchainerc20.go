@@ -0,0 +1,133 @@
+package orchestrator
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum-optimism/supersim/bindings"
+	"github.com/ethereum-optimism/supersim/config"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SuperchainERC20Deployment is a token deployed at the same address on every L2
+type SuperchainERC20Deployment struct {
+	Name     string         `json:"name"`
+	Symbol   string         `json:"symbol"`
+	Decimals uint8          `json:"decimals"`
+	Address  common.Address `json:"address"`
+}
+
+// DeploySuperchainERC20 deploys the token to the same address on every L2 and mints its balances. A token
+// that is already deployed is only minted. The token is logged by every L2 and can be bridged by name.
+func (o *Orchestrator) DeploySuperchainERC20(ctx context.Context, token config.Token) (*SuperchainERC20Deployment, error) {
+	if o.l2ToL2MsgIndexer == nil {
+		return nil, errInteropDisabled
+	}
+	if err := token.Check(); err != nil {
+		return nil, err
+	}
+
+	initCode, err := superchainERC20InitCode(token.Name, token.Symbol, token.Decimals)
+	if err != nil {
+		return nil, err
+	}
+
+	chains := o.L2Chains()
+	if len(chains) == 0 {
+		return nil, fmt.Errorf("no L2s to deploy %s to", token.Symbol)
+	}
+
+	deployer := o.l1Chain.Config().SecretsConfig.DevAccounts()[0].Address
+	addresses := make([]common.Address, len(chains))
+	err = forEachChain(chains, func(i int, chain config.Chain) error {
+		addr, _, err := deployCreate2(ctx, chain, deployer, common.Hash{}, initCode)
+		if err != nil {
+			return fmt.Errorf("failed to deploy %s on chain %s: %w", token.Symbol, chain.Config().Name, err)
+		}
+		addresses[i] = addr
+
+		for _, balance := range token.Balances {
+			if err := mintToken(ctx, chain, deployer, addr, bindings.MintableSuperchainERC20ParsedABI, balance, token.Decimals); err != nil {
+				return fmt.Errorf("failed to mint %s on chain %s: %w", token.Symbol, chain.Config().Name, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, addr := range addresses {
+		if addr != addresses[0] {
+			return nil, fmt.Errorf("%s addresses diverge, created at %s on chain %s and %s on chain %s", token.Symbol, addresses[0], chains[0].Config().Name, addr, chains[i].Config().Name)
+		}
+	}
+
+	deployment := &SuperchainERC20Deployment{Name: token.Name, Symbol: token.Symbol, Decimals: token.Decimals, Address: addresses[0]}
+	o.superchainERC20sMu.Lock()
+	defer o.superchainERC20sMu.Unlock()
+	for _, existing := range o.superchainERC20s {
+		if existing.Address == deployment.Address {
+			return deployment, nil
+		}
+	}
+
+	for _, opSim := range o.l2OpSims {
+		opSim.WatchSuperchainERC20(token.Symbol, deployment.Address)
+	}
+	o.superchainERC20s = append(o.superchainERC20s, deployment)
+
+	o.log.Info("deployed SuperchainERC20", "name", token.Name, "symbol", token.Symbol, "address", deployment.Address)
+	return deployment, nil
+}
+
+// SuperchainERC20s returns the deployed tokens, in order of deployment
+func (o *Orchestrator) SuperchainERC20s() []*SuperchainERC20Deployment {
+	o.superchainERC20sMu.Lock()
+	defer o.superchainERC20sMu.Unlock()
+	return append([]*SuperchainERC20Deployment{}, o.superchainERC20s...)
+}
+
+func (o *Orchestrator) deploySuperchainERC20s(ctx context.Context) error {
+	if len(o.config.SuperchainERC20s) > 0 && !o.config.InteropEnabled {
+		return fmt.Errorf("failed to deploy tokens: %w", errInteropDisabled)
+	}
+	for _, token := range o.config.SuperchainERC20s {
+		if _, err := o.DeploySuperchainERC20(ctx, token); err != nil {
+			return fmt.Errorf("failed to deploy token %s: %w", token.Symbol, err)
+		}
+	}
+	return nil
+}
+
+// mintToken mints the balance of an openly mintable token from the impersonated sender
+func mintToken(ctx context.Context, chain config.Chain, from, token common.Address, tokenABI *abi.ABI, balance config.FundedAccount, decimals uint8) error {
+	amount, err := balance.BaseUnits(decimals)
+	if err != nil {
+		return err
+	}
+	data, err := tokenABI.Pack("mint", balance.Address, amount)
+	if err != nil {
+		return fmt.Errorf("failed to pack mint: %w", err)
+	}
+
+	stopImpersonating, err := impersonate(ctx, chain, from)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = stopImpersonating() }()
+
+	_, err = sendAsImpersonated(ctx, chain, from, token, nil, data)
+	return err
+}
+
+// superchainERC20InitCode returns the creation code of a MintableSuperchainERC20, an openly mintable token
+// bridgeable through the SuperchainTokenBridge
+func superchainERC20InitCode(name, symbol string, decimals uint8) ([]byte, error) {
+	args, err := bindings.MintableSuperchainERC20ParsedABI.Pack("", name, symbol, decimals)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pack constructor arguments: %w", err)
+	}
+	return append(common.FromHex(bindings.MintableSuperchainERC20MetaData.Bin), args...), nil
+}
@@ -0,0 +1,85 @@
+package orchestrator
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/supersim/bindings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func callToken(t *testing.T, cfg *runtime.Config, tokenABI *abi.ABI, token common.Address, method string, args ...interface{}) []interface{} {
+	input, err := tokenABI.Pack(method, args...)
+	require.NoError(t, err)
+	ret, _, err := runtime.Call(token, input, cfg)
+	require.NoError(t, err)
+	out, err := tokenABI.Unpack(method, ret)
+	require.NoError(t, err)
+	return out
+}
+
+func TestSuperchainERC20Deployment(t *testing.T) {
+	statedb, err := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	require.NoError(t, err)
+	cfg := &runtime.Config{State: statedb, GasLimit: 10_000_000}
+
+	initCode, err := superchainERC20InitCode("My Token", "MTK", 6)
+	require.NoError(t, err)
+
+	// deployed at the expected address through the create2 factory
+	statedb.SetCode(predeploys.DeterministicDeploymentProxyAddr, common.FromHex(deterministicDeploymentProxyCode))
+	salt := common.HexToHash("0x01")
+	ret, _, err := runtime.Call(predeploys.DeterministicDeploymentProxyAddr, append(salt.Bytes(), initCode...), cfg)
+	require.NoError(t, err)
+
+	token := create2Address(salt, initCode)
+	require.Equal(t, token, common.BytesToAddress(ret))
+	require.NotEmpty(t, statedb.GetCode(token))
+
+	require.Equal(t, "My Token", callToken(t, cfg, bindings.MintableSuperchainERC20ParsedABI, token, "name")[0])
+	require.Equal(t, "MTK", callToken(t, cfg, bindings.MintableSuperchainERC20ParsedABI, token, "symbol")[0])
+	require.Equal(t, uint8(6), callToken(t, cfg, bindings.MintableSuperchainERC20ParsedABI, token, "decimals")[0])
+
+	// openly mintable
+	account := common.HexToAddress("0xbeef")
+	callToken(t, cfg, bindings.MintableSuperchainERC20ParsedABI, token, "mint", account, big.NewInt(100))
+	require.Equal(t, big.NewInt(100), callToken(t, cfg, bindings.MintableSuperchainERC20ParsedABI, token, "balanceOf", account)[0])
+
+	// the EIP-2612 domain uses the name of the token
+	bytes32, err := abi.NewType("bytes32", "", nil)
+	require.NoError(t, err)
+	uint256, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+	address, err := abi.NewType("address", "", nil)
+	require.NoError(t, err)
+	domain, err := abi.Arguments{{Type: bytes32}, {Type: bytes32}, {Type: bytes32}, {Type: uint256}, {Type: address}}.Pack(
+		crypto.Keccak256Hash([]byte("EIP712Domain(string name,string version,uint256 chainId,address verifyingContract)")),
+		crypto.Keccak256Hash([]byte("My Token")),
+		crypto.Keccak256Hash([]byte("1")),
+		cfg.ChainConfig.ChainID,
+		token,
+	)
+	require.NoError(t, err)
+	require.Equal(t, crypto.Keccak256Hash(domain), common.Hash(callToken(t, cfg, bindings.MintableSuperchainERC20ParsedABI, token, "DOMAIN_SEPARATOR")[0].([32]byte)))
+}
+
+func TestSuperchainERC20Address(t *testing.T) {
+	a, err := superchainERC20InitCode("My Token", "MTK", 18)
+	require.NoError(t, err)
+	b, err := superchainERC20InitCode("My Token", "MTK", 6)
+	require.NoError(t, err)
+
+	// distinct tokens are deployed to distinct addresses, the same token to the same address
+	require.NotEqual(t, create2Address(common.Hash{}, a), create2Address(common.Hash{}, b))
+	c, err := superchainERC20InitCode("My Token", "MTK", 18)
+	require.NoError(t, err)
+	require.Equal(t, create2Address(common.Hash{}, a), create2Address(common.Hash{}, c))
+}
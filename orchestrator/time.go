@@ -0,0 +1,126 @@
+package orchestrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum-optimism/supersim/config"
+)
+
+// AdvanceTime moves the L1 and every L2 forward by the specified number of seconds, relative
+// to the most recent block timestamp across all chains. Returns the new timestamp of the network
+func (o *Orchestrator) AdvanceTime(ctx context.Context, seconds uint64) (uint64, error) {
+	if seconds == 0 {
+		return 0, errors.New("seconds must be greater than zero")
+	}
+
+	latestTimestamp, err := o.latestTimestamp(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := latestTimestamp + seconds
+	if err := o.mineAtTimestamp(ctx, timestamp); err != nil {
+		return 0, err
+	}
+
+	o.log.Info("advanced superchain time", "seconds", seconds, "timestamp", timestamp)
+	return timestamp, nil
+}
+
+// SetTime moves the L1 and every L2 to the specified timestamp. Since chains cannot
+// go back in time, the timestamp must be ahead of the latest block on every chain
+func (o *Orchestrator) SetTime(ctx context.Context, timestamp uint64) error {
+	latestTimestamp, err := o.latestTimestamp(ctx)
+	if err != nil {
+		return err
+	}
+	if timestamp <= latestTimestamp {
+		return fmt.Errorf("timestamp %d must be greater than the latest block timestamp %d", timestamp, latestTimestamp)
+	}
+
+	if err := o.mineAtTimestamp(ctx, timestamp); err != nil {
+		return err
+	}
+
+	o.log.Info("set superchain time", "timestamp", timestamp)
+	return nil
+}
+
+// latestTimestamp returns the highest block timestamp across the L1 and all L2s
+func (o *Orchestrator) latestTimestamp(ctx context.Context) (uint64, error) {
+	var latestTimestamp uint64
+	for _, chain := range o.allChains() {
+		header, err := chain.EthClient().HeaderByNumber(ctx, nil)
+		if err != nil {
+			return 0, fmt.Errorf("failed to fetch latest header for chain %s: %w", chain.Config().Name, err)
+		}
+		latestTimestamp = max(latestTimestamp, header.Time)
+	}
+
+	return latestTimestamp, nil
+}
+
+// mineAtTimestamp mines the blocks of every chain up to the timestamp, one every block time of the chain, such that
+// the chains remain in step and the block numbers of the L2s match their timestamps. Subsequent blocks are built
+// relative to the last block mined
+func (o *Orchestrator) mineAtTimestamp(ctx context.Context, timestamp uint64) error {
+	return forEachChain(o.allChains(), func(_ int, chain config.Chain) error {
+		if err := mineUntil(ctx, chain, timestamp); err != nil {
+			return fmt.Errorf("failed to mine chain %s: %w", chain.Config().Name, err)
+		}
+		return nil
+	})
+}
+
+// mineUntil mines a block every block time of the chain until a block is at or past the timestamp. Interval
+// mining is paused meanwhile and restarted according to the mining mode of the chain
+func mineUntil(ctx context.Context, chain config.Chain, timestamp uint64) error {
+	if err := chain.SetIntervalMining(ctx, nil, 0); err != nil {
+		return fmt.Errorf("failed to pause interval mining: %w", err)
+	}
+	defer func() { _ = startMining(context.Background(), chain) }()
+
+	header, err := chain.EthClient().HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch latest header: %w", err)
+	}
+
+	blockTime := chainBlockTime(chain.Config())
+	blocks := uint64(1)
+	if timestamp > header.Time {
+		blocks = (timestamp - header.Time + blockTime - 1) / blockTime
+	}
+
+	if blocks > 1 {
+		if err := chain.SetBlockTimestampInterval(ctx, nil, blockTime); err != nil {
+			return fmt.Errorf("failed to set block timestamp interval: %w", err)
+		}
+		err := chain.Mine(ctx, nil, blocks-1)
+		if removeErr := chain.RemoveBlockTimestampInterval(ctx, nil); removeErr != nil && err == nil {
+			err = fmt.Errorf("failed to remove block timestamp interval: %w", removeErr)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to mine %d blocks: %w", blocks-1, err)
+		}
+	}
+
+	// The last block is mined at an exact timestamp, which moves the clock of the chain forward
+	if err := chain.SetNextBlockTimestamp(ctx, nil, header.Time+blocks*blockTime); err != nil {
+		return fmt.Errorf("failed to set next block timestamp: %w", err)
+	}
+	if err := chain.Mine(ctx, nil, 1); err != nil {
+		return fmt.Errorf("failed to mine block: %w", err)
+	}
+	return nil
+}
+
+// allChains returns the L1 followed by the underlying L2 chains
+func (o *Orchestrator) allChains() []config.Chain {
+	chains := []config.Chain{o.l1Chain}
+	for _, chain := range o.l2Chains {
+		chains = append(chains, chain)
+	}
+	return chains
+}
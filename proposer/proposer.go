@@ -0,0 +1,379 @@
+package proposer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum-optimism/optimism/op-chain-ops/devkeys"
+	opbindings "github.com/ethereum-optimism/optimism/op-e2e/bindings"
+	"github.com/ethereum-optimism/optimism/op-service/eth"
+	"github.com/ethereum-optimism/optimism/op-service/predeploys"
+	"github.com/ethereum-optimism/optimism/op-service/tasks"
+	"github.com/ethereum-optimism/supersim/config"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// PermissionedGameType is the respected game type of the local deployments
+	PermissionedGameType uint32 = 1
+
+	gameStatusInProgress uint8 = 0
+)
+
+// L2OutputProposer posts a dispute game to the L1 for the latest output of each local L2, such
+// that withdrawals can be proven, and resolves the games once their clock has expired.
+type L2OutputProposer struct {
+	logger   log.Logger
+	interval time.Duration
+
+	l1Client *ethclient.Client
+
+	tasks       tasks.Group
+	tasksCtx    context.Context
+	tasksCancel context.CancelFunc
+}
+
+// chainProposer holds the proposal state of a single L2
+type chainProposer struct {
+	logger log.Logger
+
+	l1Client   *ethclient.Client
+	l2Client   *ethclient.Client
+	transactor *bind.TransactOpts
+
+	disputeGameFactory *opbindings.DisputeGameFactory
+
+	lastProposedBlock uint64
+	scannedGames      uint64
+	unresolvedGames   []unresolvedGame
+}
+
+// unresolvedGame is a game whose resolution isn't attempted until its clock can have expired
+type unresolvedGame struct {
+	address      common.Address
+	resolvableAt uint64
+}
+
+func NewL2OutputProposer(logger log.Logger, interval time.Duration) *L2OutputProposer {
+	tasksCtx, tasksCancel := context.WithCancel(context.Background())
+
+	return &L2OutputProposer{
+		logger:   logger,
+		interval: interval,
+		tasks: tasks.Group{
+			HandleCrit: func(err error) {
+				logger.Error("unhandled proposer error", "err", err)
+			},
+		},
+		tasksCtx:    tasksCtx,
+		tasksCancel: tasksCancel,
+	}
+}
+
+// Address returns the proposer of the L2, which creates and resolves its games. The account holds the bond of each game
+func Address(l2ChainID uint64) (common.Address, error) {
+	keys, err := devkeys.NewMnemonicDevKeys(devkeys.TestMnemonic)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to create dev keys: %w", err)
+	}
+	return keys.Address(devkeys.ChainOperatorKeys(new(big.Int).SetUint64(l2ChainID))(devkeys.ProposerRole))
+}
+
+// Start proposes outputs for every L2 with a DisputeGameFactory deployed to the L1
+func (p *L2OutputProposer) Start(l1Client *ethclient.Client, l2Chains []config.Chain) error {
+	p.l1Client = l1Client
+
+	keys, err := devkeys.NewMnemonicDevKeys(devkeys.TestMnemonic)
+	if err != nil {
+		return fmt.Errorf("failed to create dev keys: %w", err)
+	}
+
+	l1ChainID, err := l1Client.ChainID(p.tasksCtx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch l1 chain id: %w", err)
+	}
+
+	for _, chain := range l2Chains {
+		cfg := chain.Config()
+		if cfg.L2Config == nil || cfg.L2Config.L1Addresses == nil {
+			continue
+		}
+
+		disputeGameFactoryAddr := common.Address(cfg.L2Config.L1Addresses.DisputeGameFactoryProxy)
+		if disputeGameFactoryAddr == (common.Address{}) {
+			continue
+		}
+
+		// The permissioned game is only created by the proposer of the chain
+		privateKey, err := keys.Secret(devkeys.ChainOperatorKeys(new(big.Int).SetUint64(cfg.ChainID))(devkeys.ProposerRole))
+		if err != nil {
+			return fmt.Errorf("failed to derive proposer key of chain %d: %w", cfg.ChainID, err)
+		}
+
+		// we force the curve to Geth's instance, because Geth does an equality check in the nocgo version:
+		// https://github.com/ethereum/go-ethereum/blob/723b1e36ad6a9e998f06f74cc8b11d51635c6402/crypto/signature_nocgo.go#L82
+		privateKey.PublicKey.Curve = crypto.S256()
+
+		transactor, err := bind.NewKeyedTransactorWithChainID(privateKey, l1ChainID)
+		if err != nil {
+			return fmt.Errorf("failed to create transactor: %w", err)
+		}
+
+		disputeGameFactory, err := opbindings.NewDisputeGameFactory(disputeGameFactoryAddr, l1Client)
+		if err != nil {
+			return fmt.Errorf("failed to bind dispute game factory: %w", err)
+		}
+
+		cp := &chainProposer{
+			logger:             p.logger.New("chain.id", cfg.ChainID),
+			l1Client:           l1Client,
+			l2Client:           chain.EthClient(),
+			transactor:         transactor,
+			disputeGameFactory: disputeGameFactory,
+		}
+
+		p.tasks.Go(func() error {
+			ticker := time.NewTicker(p.interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-p.tasksCtx.Done():
+					return nil
+				case <-ticker.C:
+					// failures are retried on the next tick, i.e when the L1 is not mining
+					if err := cp.resolveGames(p.tasksCtx); err != nil && !errors.Is(err, context.Canceled) {
+						cp.logger.Warn("failed to resolve dispute games", "err", err)
+					}
+					if err := cp.proposeOutput(p.tasksCtx); err != nil && !errors.Is(err, context.Canceled) {
+						cp.logger.Warn("failed to propose l2 output", "err", err)
+					}
+				}
+			}
+		})
+	}
+
+	return nil
+}
+
+// Stop cancels the proposals and waits for any game being created or resolved
+func (p *L2OutputProposer) Stop(ctx context.Context) {
+	p.tasksCancel()
+	if err := p.tasks.Wait(); err != nil {
+		p.logger.Error("proposer tasks failed", "err", err)
+	}
+}
+
+// proposeOutput creates a game for the output of the latest L2 block
+func (cp *chainProposer) proposeOutput(ctx context.Context) error {
+	blockNumber, err := cp.l2Client.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch latest l2 block number: %w", err)
+	}
+
+	// The anchor state starts at genesis, only later outputs can be proposed
+	if blockNumber == 0 || blockNumber == cp.lastProposedBlock {
+		return nil
+	}
+
+	outputRoot, err := OutputRoot(ctx, cp.l2Client, blockNumber)
+	if err != nil {
+		return err
+	}
+
+	bond, err := cp.disputeGameFactory.InitBonds(&bind.CallOpts{Context: ctx}, PermissionedGameType)
+	if err != nil {
+		return fmt.Errorf("failed to fetch init bond: %w", err)
+	}
+
+	opts := *cp.transactor
+	opts.Context = ctx
+	opts.Value = bond
+
+	tx, err := cp.disputeGameFactory.Create(&opts, PermissionedGameType, outputRoot, EncodeL2BlockNumber(blockNumber))
+	if err != nil {
+		return fmt.Errorf("failed to create dispute game: %w", err)
+	}
+	if err := cp.waitForSuccess(ctx, tx); err != nil {
+		return fmt.Errorf("failed to create dispute game: %w", err)
+	}
+
+	cp.logger.Debug("proposed l2 output", "l2.block", blockNumber, "output.root", common.Hash(outputRoot))
+	cp.lastProposedBlock = blockNumber
+	return nil
+}
+
+// resolveGames resolves the root claim of unchallenged games once their clock has expired and then the games
+// themselves, one step per call. With the long clock of the permissioned game, this requires advancing the L1 time.
+// Games are only polled once the L1 time has passed their creation plus the max clock duration.
+func (cp *chainProposer) resolveGames(ctx context.Context) error {
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	gameCount, err := cp.disputeGameFactory.GameCount(callOpts)
+	if err != nil {
+		return fmt.Errorf("failed to fetch game count: %w", err)
+	}
+
+	// the L1 was reverted, any tracked game is gone
+	if gameCount.Uint64() < cp.scannedGames {
+		cp.scannedGames, cp.unresolvedGames = 0, nil
+	}
+
+	for ; cp.scannedGames < gameCount.Uint64(); cp.scannedGames++ {
+		game, err := cp.disputeGameFactory.GameAtIndex(callOpts, new(big.Int).SetUint64(cp.scannedGames))
+		if err != nil {
+			return fmt.Errorf("failed to fetch game %d: %w", cp.scannedGames, err)
+		}
+		if game.GameType != PermissionedGameType {
+			continue
+		}
+
+		faultDisputeGame, err := opbindings.NewFaultDisputeGameCaller(game.Proxy, cp.l1Client)
+		if err != nil {
+			return fmt.Errorf("failed to bind dispute game: %w", err)
+		}
+		maxClockDuration, err := faultDisputeGame.MaxClockDuration(callOpts)
+		if err != nil {
+			return fmt.Errorf("failed to fetch max clock duration of game %d: %w", cp.scannedGames, err)
+		}
+		cp.unresolvedGames = append(cp.unresolvedGames, unresolvedGame{address: game.Proxy, resolvableAt: game.Timestamp + maxClockDuration})
+	}
+	if len(cp.unresolvedGames) == 0 {
+		return nil
+	}
+
+	header, err := cp.l1Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to fetch latest l1 header: %w", err)
+	}
+
+	var unresolvedGames []unresolvedGame
+	for _, game := range cp.unresolvedGames {
+		if header.Time < game.resolvableAt {
+			unresolvedGames = append(unresolvedGames, game)
+			continue
+		}
+
+		resolved, err := cp.resolveGame(ctx, game.address)
+		if err != nil {
+			cp.logger.Debug("failed to resolve dispute game", "game", game.address, "err", err)
+		}
+		if !resolved {
+			unresolvedGames = append(unresolvedGames, game)
+		}
+	}
+
+	cp.unresolvedGames = unresolvedGames
+	return nil
+}
+
+// resolveGame advances the resolution of the game, returning true once it no longer needs to be tracked
+func (cp *chainProposer) resolveGame(ctx context.Context, gameAddr common.Address) (bool, error) {
+	callOpts := &bind.CallOpts{Context: ctx}
+
+	game, err := opbindings.NewFaultDisputeGame(gameAddr, cp.l1Client)
+	if err != nil {
+		return false, fmt.Errorf("failed to bind dispute game: %w", err)
+	}
+
+	status, err := game.Status(callOpts)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch game status: %w", err)
+	}
+	if status != gameStatusInProgress {
+		return true, nil
+	}
+
+	opts := *cp.transactor
+	opts.Context = ctx
+
+	rootResolved, err := game.ResolvedSubgames(callOpts, common.Big0)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch root claim resolution: %w", err)
+	}
+	if rootResolved {
+		tx, err := game.Resolve(&opts)
+		if err != nil {
+			return false, fmt.Errorf("failed to resolve game: %w", err)
+		}
+		if err := cp.waitForSuccess(ctx, tx); err != nil {
+			return false, fmt.Errorf("failed to resolve game: %w", err)
+		}
+
+		cp.logger.Debug("resolved dispute game", "game", gameAddr)
+		return true, nil
+	}
+
+	duration, err := game.GetChallengerDuration(callOpts, common.Big0)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch challenger duration: %w", err)
+	}
+	maxClockDuration, err := game.MaxClockDuration(callOpts)
+	if err != nil {
+		return false, fmt.Errorf("failed to fetch max clock duration: %w", err)
+	}
+	if duration < maxClockDuration {
+		return false, nil
+	}
+
+	tx, err := game.ResolveClaim(&opts, common.Big0, common.Big0)
+	if err != nil {
+		return false, fmt.Errorf("failed to resolve root claim: %w", err)
+	}
+	if err := cp.waitForSuccess(ctx, tx); err != nil {
+		return false, fmt.Errorf("failed to resolve root claim: %w", err)
+	}
+	return false, nil
+}
+
+func (cp *chainProposer) waitForSuccess(ctx context.Context, tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, cp.l1Client, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return nil
+}
+
+// OutputRoot computes the output root of the L2 block, committing to the L2ToL1MessagePasser storage
+// such that withdrawals initiated up until this block can be proven against it.
+func OutputRoot(ctx context.Context, l2Client *ethclient.Client, blockNumber uint64) (eth.Bytes32, error) {
+	// The block hash is read as returned by the chain rather than recomputed from the decoded header
+	var block struct {
+		Hash      common.Hash `json:"hash"`
+		StateRoot common.Hash `json:"stateRoot"`
+	}
+	if err := l2Client.Client().CallContext(ctx, &block, "eth_getBlockByNumber", hexutil.Uint64(blockNumber), false); err != nil {
+		return eth.Bytes32{}, fmt.Errorf("failed to fetch l2 block %d: %w", blockNumber, err)
+	}
+
+	proof, err := gethclient.New(l2Client.Client()).GetProof(ctx, predeploys.L2ToL1MessagePasserAddr, nil, new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return eth.Bytes32{}, fmt.Errorf("failed to fetch L2ToL1MessagePasser proof: %w", err)
+	}
+
+	output := &eth.OutputV0{
+		StateRoot:                eth.Bytes32(block.StateRoot),
+		MessagePasserStorageRoot: eth.Bytes32(proof.StorageHash),
+		BlockHash:                block.Hash,
+	}
+	return eth.OutputRoot(output), nil
+}
+
+// EncodeL2BlockNumber returns the extra data of an output proposal, the abi encoded L2 block number
+func EncodeL2BlockNumber(blockNumber uint64) []byte {
+	return common.BigToHash(new(big.Int).SetUint64(blockNumber)).Bytes()
+}
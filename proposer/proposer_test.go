@@ -0,0 +1,18 @@
+package proposer
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeL2BlockNumber(t *testing.T) {
+	uint256Type, err := abi.NewType("uint256", "", nil)
+	require.NoError(t, err)
+
+	expected, err := abi.Arguments{{Type: uint256Type}}.Pack(big.NewInt(1234))
+	require.NoError(t, err)
+	require.Equal(t, expected, EncodeL2BlockNumber(1234))
+}
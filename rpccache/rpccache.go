@@ -0,0 +1,347 @@
+package rpccache
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	// Error code returned for requests without a recorded response
+	CacheMissErrorCode = -32000
+
+	maxRequestBodySize = 32 * 1024 * 1024
+)
+
+// Cache serves JSON-RPC proxies which either record the responses of an upstream
+// rpc to disk or replay previously recorded responses without any network access.
+type Cache struct {
+	log log.Logger
+
+	dir    string
+	replay bool
+
+	mu      sync.Mutex
+	servers []*http.Server
+	wg      sync.WaitGroup
+}
+
+type jsonrpcMessage struct {
+	Version string          `json:"jsonrpc,omitempty"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+	Result  json.RawMessage `json:"result,omitempty"`
+	Error   *jsonrpcError   `json:"error,omitempty"`
+}
+
+type jsonrpcError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+const (
+	// Codes of the JSON-RPC errors returned by upstreams that failed to serve the request
+	internalErrorCode      = -32603
+	limitExceededErrorCode = -32005
+)
+
+// Messages of the errors returned by upstreams, under the generic server error code, that failed to serve the request
+var transientErrorMessages = []string{"rate limit", "too many requests", "capacity", "timeout", "timed out", "header not found", "unavailable"}
+
+// entry is the on-disk representation of a recorded response
+type entry struct {
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *jsonrpcError   `json:"error,omitempty"`
+}
+
+func NewRecordingCache(log log.Logger, dir string) *Cache {
+	return &Cache{log: log, dir: dir}
+}
+
+func NewReplayCache(log log.Logger, dir string) *Cache {
+	return &Cache{log: log, dir: dir, replay: true}
+}
+
+// Proxy starts a local proxy for the named upstream and returns its url. Recorded responses
+// are stored by name, so the same name must be used when replaying. The upstream url is
+// unused when replaying.
+func (c *Cache) Proxy(name, upstreamURL string) (string, error) {
+	dir := filepath.Join(c.dir, strings.ToLower(name))
+	if !c.replay {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return "", fmt.Errorf("failed to create cache directory: %w", err)
+		}
+	} else if _, err := os.Stat(dir); err != nil {
+		return "", fmt.Errorf("no recorded responses for %s: %w", name, err)
+	}
+
+	p := &proxy{log: c.log.New("upstream", name), dir: dir, upstreamURL: upstreamURL, replay: c.replay}
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", fmt.Errorf("failed to listen: %w", err)
+	}
+
+	srv := &http.Server{Handler: p}
+	c.mu.Lock()
+	c.servers = append(c.servers, srv)
+	c.mu.Unlock()
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		if err := srv.Serve(listener); err != nil && err != http.ErrServerClosed {
+			c.log.Error("rpc cache proxy failed to serve", "upstream", name, "error", err)
+		}
+	}()
+
+	url := fmt.Sprintf("http://%s", listener.Addr().String())
+	c.log.Debug("started rpc cache proxy", "upstream", name, "url", url, "replay", c.replay)
+	return url, nil
+}
+
+// Close shuts down all running proxies
+func (c *Cache) Close(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var errs []error
+	for _, srv := range c.servers {
+		if err := srv.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	c.wg.Wait()
+	c.servers = nil
+	return errors.Join(errs...)
+}
+
+type proxy struct {
+	log log.Logger
+
+	dir         string
+	upstreamURL string
+	replay      bool
+}
+
+func (p *proxy) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBodySize))
+	if err != nil {
+		http.Error(w, "failed to read request", http.StatusBadRequest)
+		return
+	}
+
+	body = bytes.TrimSpace(body)
+	isBatch := len(body) > 0 && body[0] == '['
+
+	var reqs []*jsonrpcMessage
+	if isBatch {
+		err = json.Unmarshal(body, &reqs)
+	} else {
+		req := new(jsonrpcMessage)
+		err = json.Unmarshal(body, req)
+		reqs = append(reqs, req)
+	}
+	if err != nil {
+		http.Error(w, "invalid json-rpc request", http.StatusBadRequest)
+		return
+	}
+
+	var resps []*jsonrpcMessage
+	if p.replay {
+		resps = p.replayRequests(reqs)
+	} else {
+		resps, err = p.recordRequests(r.Context(), body, reqs)
+		if err != nil {
+			p.log.Error("failed to forward request", "error", err)
+			http.Error(w, err.Error(), http.StatusBadGateway)
+			return
+		}
+	}
+
+	var resp interface{} = resps
+	if !isBatch {
+		resp = resps[0]
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		p.log.Error("failed to write response", "error", err)
+	}
+}
+
+// recordRequests forwards the request body to the upstream and stores every response
+func (p *proxy) recordRequests(ctx context.Context, body []byte, reqs []*jsonrpcMessage) ([]*jsonrpcMessage, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.upstreamURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	httpResp, err := http.DefaultClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("upstream request failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read upstream response: %w", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("upstream responded with status %d: %s", httpResp.StatusCode, respBody)
+	}
+
+	respBody = bytes.TrimSpace(respBody)
+	var resps []*jsonrpcMessage
+	if len(respBody) > 0 && respBody[0] == '[' {
+		err = json.Unmarshal(respBody, &resps)
+	} else {
+		resp := new(jsonrpcMessage)
+		err = json.Unmarshal(respBody, resp)
+		resps = append(resps, resp)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid upstream response: %w", err)
+	}
+
+	// batch responses may be returned in any order
+	respsByID := make(map[string]*jsonrpcMessage, len(resps))
+	for _, resp := range resps {
+		respsByID[string(resp.ID)] = resp
+	}
+
+	for _, req := range reqs {
+		// transient errors, i.e rate limits or a node that is not synced yet, are not replayed
+		resp, ok := respsByID[string(req.ID)]
+		if !ok || (resp.Error != nil && isTransientError(resp.Error)) {
+			continue
+		}
+		if err := p.store(req, resp); err != nil {
+			p.log.Error("failed to record response", "method", req.Method, "error", err)
+		}
+	}
+
+	return resps, nil
+}
+
+// isTransientError reports whether the error is caused by the state of the upstream rather than the request, such
+// that the request may succeed when retried. Execution errors, such as the revert data of `eth_call`, are deterministic
+// at the pinned fork height and are recorded
+func isTransientError(e *jsonrpcError) bool {
+	if e.Code == internalErrorCode || e.Code == limitExceededErrorCode {
+		return true
+	}
+
+	msg := strings.ToLower(e.Message)
+	for _, transient := range transientErrorMessages {
+		if strings.Contains(msg, transient) {
+			return true
+		}
+	}
+	return false
+}
+
+// replayRequests responds to every request from the recorded responses
+func (p *proxy) replayRequests(reqs []*jsonrpcMessage) []*jsonrpcMessage {
+	resps := make([]*jsonrpcMessage, len(reqs))
+	for i, req := range reqs {
+		resp := &jsonrpcMessage{Version: "2.0", ID: req.ID}
+
+		e, err := p.load(req)
+		if err != nil {
+			p.log.Error("rpc cache miss", "method", req.Method, "params", string(req.Params), "error", err)
+			resp.Error = &jsonrpcError{
+				Code:    CacheMissErrorCode,
+				Message: fmt.Sprintf("rpc cache miss: no recorded response for %s %s", req.Method, req.Params),
+			}
+		} else {
+			resp.Result, resp.Error = e.Result, e.Error
+		}
+
+		resps[i] = resp
+	}
+
+	return resps
+}
+
+func (p *proxy) store(req, resp *jsonrpcMessage) error {
+	data, err := json.Marshal(&entry{Method: req.Method, Params: req.Params, Result: resp.Result, Error: resp.Error})
+	if err != nil {
+		return err
+	}
+
+	key, err := cacheKey(req)
+	if err != nil {
+		return err
+	}
+
+	// write then rename such that a partially written entry is never replayed
+	path := filepath.Join(p.dir, key+".json")
+	tmpFile, err := os.CreateTemp(p.dir, key+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmpFile.Write(data); err != nil {
+		tmpFile.Close()
+		os.Remove(tmpFile.Name())
+		return err
+	}
+	if err := tmpFile.Close(); err != nil {
+		os.Remove(tmpFile.Name())
+		return err
+	}
+
+	return os.Rename(tmpFile.Name(), path)
+}
+
+func (p *proxy) load(req *jsonrpcMessage) (*entry, error) {
+	key, err := cacheKey(req)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(p.dir, key+".json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var e entry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, fmt.Errorf("corrupt cache entry: %w", err)
+	}
+	return &e, nil
+}
+
+// cacheKey identifies a request by its method and params, ignoring the request id
+func cacheKey(req *jsonrpcMessage) (string, error) {
+	var params bytes.Buffer
+	if len(req.Params) > 0 {
+		if err := json.Compact(&params, req.Params); err != nil {
+			return "", fmt.Errorf("invalid params: %w", err)
+		}
+	}
+
+	h := sha256.New()
+	h.Write([]byte(req.Method))
+	h.Write([]byte{0})
+	h.Write(params.Bytes())
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,168 @@
+package rpccache
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+type testService struct {
+	calls int
+}
+
+func (s *testService) Echo(value string) string {
+	s.calls++
+	return value
+}
+
+func (s *testService) Fail() (string, error) {
+	s.calls++
+	return "", errors.New("unavailable")
+}
+
+func (s *testService) Revert() (string, error) {
+	s.calls++
+	return "", &revertError{}
+}
+
+// revertError is the error of a reverted `eth_call`, including the revert data
+type revertError struct{}
+
+func (e *revertError) Error() string          { return "execution reverted" }
+func (e *revertError) ErrorCode() int         { return 3 }
+func (e *revertError) ErrorData() interface{} { return "0x08c379a0" }
+
+func TestRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	service := &testService{}
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("test", service))
+	upstream := httptest.NewServer(rpcServer)
+
+	// Record
+	recordingCache := NewRecordingCache(log.New(), dir)
+	url, err := recordingCache.Proxy("upstream", upstream.URL)
+	require.NoError(t, err)
+
+	client, err := rpc.DialContext(ctx, url)
+	require.NoError(t, err)
+
+	var result string
+	require.NoError(t, client.CallContext(ctx, &result, "test_echo", "hello"))
+	require.Equal(t, "hello", result)
+
+	batch := []rpc.BatchElem{
+		{Method: "test_echo", Args: []interface{}{"a"}, Result: new(string)},
+		{Method: "test_echo", Args: []interface{}{"b"}, Result: new(string)},
+	}
+	require.NoError(t, client.BatchCallContext(ctx, batch))
+	require.Equal(t, 3, service.calls)
+
+	client.Close()
+	require.NoError(t, recordingCache.Close(ctx))
+
+	// Replay without the upstream
+	upstream.Close()
+
+	replayCache := NewReplayCache(log.New(), dir)
+	url, err = replayCache.Proxy("upstream", "")
+	require.NoError(t, err)
+	defer replayCache.Close(ctx)
+
+	client, err = rpc.DialContext(ctx, url)
+	require.NoError(t, err)
+	defer client.Close()
+
+	require.NoError(t, client.CallContext(ctx, &result, "test_echo", "hello"))
+	require.Equal(t, "hello", result)
+
+	batch = []rpc.BatchElem{
+		{Method: "test_echo", Args: []interface{}{"b"}, Result: new(string)},
+		{Method: "test_echo", Args: []interface{}{"c"}, Result: new(string)},
+	}
+	require.NoError(t, client.BatchCallContext(ctx, batch))
+	require.NoError(t, batch[0].Error)
+	require.Equal(t, "b", *batch[0].Result.(*string))
+
+	// cache miss
+	require.Error(t, batch[1].Error)
+	require.Contains(t, batch[1].Error.Error(), "rpc cache miss")
+	require.Equal(t, 3, service.calls)
+}
+
+func TestReplayMissingUpstream(t *testing.T) {
+	replayCache := NewReplayCache(log.New(), t.TempDir())
+	_, err := replayCache.Proxy("missing", "")
+	require.Error(t, err)
+}
+
+func TestRecordUpstreamError(t *testing.T) {
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer upstream.Close()
+
+	recordingCache := NewRecordingCache(log.New(), t.TempDir())
+	url, err := recordingCache.Proxy("upstream", upstream.URL)
+	require.NoError(t, err)
+	defer recordingCache.Close(context.Background())
+
+	client, err := rpc.Dial(url)
+	require.NoError(t, err)
+	defer client.Close()
+
+	var result string
+	require.Error(t, client.Call(&result, "test_echo", "hello"))
+}
+
+func TestTransientErrorsAreNotRecorded(t *testing.T) {
+	dir := t.TempDir()
+	ctx := context.Background()
+
+	rpcServer := rpc.NewServer()
+	require.NoError(t, rpcServer.RegisterName("test", &testService{}))
+	upstream := httptest.NewServer(rpcServer)
+	defer upstream.Close()
+
+	recordingCache := NewRecordingCache(log.New(), dir)
+	url, err := recordingCache.Proxy("upstream", upstream.URL)
+	require.NoError(t, err)
+
+	client, err := rpc.Dial(url)
+	require.NoError(t, err)
+
+	var result string
+	require.Error(t, client.CallContext(ctx, &result, "test_fail"))
+	require.Error(t, client.CallContext(ctx, &result, "test_revert"))
+	client.Close()
+	require.NoError(t, recordingCache.Close(ctx))
+
+	replayCache := NewReplayCache(log.New(), dir)
+	url, err = replayCache.Proxy("upstream", "")
+	require.NoError(t, err)
+	defer replayCache.Close(ctx)
+
+	client, err = rpc.Dial(url)
+	require.NoError(t, err)
+	defer client.Close()
+
+	// the transient failure is a cache miss rather than replaying the error
+	err = client.CallContext(ctx, &result, "test_fail")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "rpc cache miss")
+
+	// the execution error is replayed along with its data
+	err = client.CallContext(ctx, &result, "test_revert")
+	var dataErr rpc.DataError
+	require.ErrorAs(t, err, &dataErr)
+	require.Equal(t, "execution reverted", dataErr.Error())
+	require.Equal(t, "0x08c379a0", dataErr.ErrorData())
+}
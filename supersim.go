@@ -2,16 +2,20 @@ package supersim
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
 	"github.com/ethereum-optimism/optimism/op-service/predeploys"
-	registry "github.com/ethereum-optimism/superchain-registry/superchain"
 	"github.com/ethereum-optimism/supersim/admin"
 	"github.com/ethereum-optimism/supersim/config"
+	"github.com/ethereum-optimism/supersim/genesis"
+	"github.com/ethereum-optimism/supersim/genesis/worldgen"
 	"github.com/ethereum-optimism/supersim/orchestrator"
+	"github.com/ethereum-optimism/supersim/rpccache"
 
 	"github.com/ethereum/go-ethereum/log"
 )
@@ -25,31 +29,60 @@ type Supersim struct {
 	Orchestrator *orchestrator.Orchestrator
 
 	adminServer *admin.AdminServer
+	rpcCache    *rpccache.Cache
 }
 
 func NewSupersim(log log.Logger, envPrefix string, closeApp context.CancelCauseFunc, cliConfig *config.CLIConfig) (*Supersim, error) {
 	networkConfig := config.GetDefaultNetworkConfig(uint64(time.Now().Unix()), cliConfig.LogsDirectory)
 
+	// If a custom genesis is specified, run the local chains from it instead
+	if cliConfig.GenesisConfig != nil {
+		deployment, err := loadGenesisDeployment(log, cliConfig.GenesisConfig)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load genesis: %w", err)
+		}
+
+		startingTimestamp := cliConfig.GenesisConfig.GenesisTimestamp
+		if startingTimestamp == 0 {
+			startingTimestamp = uint64(time.Now().Unix())
+		}
+		networkConfig = config.NetworkConfigFromGenesisDeployment(deployment, startingTimestamp, cliConfig.LogsDirectory)
+	}
+
 	// If Forking, override the network config with the generated fork config
+	var rpcCache *rpccache.Cache
 	if cliConfig.ForkConfig != nil {
-		superchain := registry.Superchains[cliConfig.ForkConfig.Network]
-		log.Info("generating fork configuration", "superchain", superchain.Superchain)
+		log.Info("generating fork configuration", "network", cliConfig.ForkConfig.Network)
+
+		if dir := cliConfig.ForkConfig.RecordDir; dir != "" {
+			log.Info("recording upstream rpc responses", "dir", dir)
+			rpcCache = rpccache.NewRecordingCache(log, dir)
+		} else if dir := cliConfig.ForkConfig.ReplayDir; dir != "" {
+			log.Info("replaying recorded upstream rpc responses", "dir", dir)
+			rpcCache = rpccache.NewReplayCache(log, dir)
+		}
 
 		var err error
-		networkConfig, err = orchestrator.NetworkConfigFromForkCLIConfig(log, envPrefix, cliConfig)
+		networkConfig, err = orchestrator.NetworkConfigFromForkCLIConfig(log, envPrefix, cliConfig, rpcCache)
 		if err != nil {
+			if rpcCache != nil {
+				_ = rpcCache.Close(context.Background())
+			}
 			return nil, fmt.Errorf("failed to construct fork configuration: %w", err)
 		}
 
 		l1ForkHeightStr := "latest"
-		if cliConfig.ForkConfig.L1ForkHeight > 0 {
-			l1ForkHeightStr = fmt.Sprintf("%d", cliConfig.ForkConfig.L1ForkHeight)
+		if cliConfig.ForkConfig.L1ForkHeight > 0 || cliConfig.ForkConfig.ForkTimestamp > 0 {
+			l1ForkHeightStr = fmt.Sprintf("%d", networkConfig.L1Config.ForkConfig.BlockNumber)
 		}
 
-		log.Info("forked l1 chain config", "name", superchain.Superchain, "chain.id", networkConfig.L1Config.ChainID, "fork.height", l1ForkHeightStr)
+		log.Info("forked l1 chain config", "name", networkConfig.L1Config.Name, "chain.id", networkConfig.L1Config.ChainID, "fork.height", l1ForkHeightStr)
 		for _, chainCfg := range networkConfig.L2Configs {
-			name := registry.OPChains[chainCfg.ChainID].Chain
-			log.Info("forked l2 chain config", "name", name, "chain.id", chainCfg.ChainID, "fork.height", chainCfg.ForkConfig.BlockNumber)
+			if chainCfg.ForkConfig == nil {
+				log.Info("local l2 chain config", "name", chainCfg.Name, "chain.id", chainCfg.ChainID)
+				continue
+			}
+			log.Info("forked l2 chain config", "name", chainCfg.Name, "chain.id", chainCfg.ChainID, "fork.height", chainCfg.ForkConfig.BlockNumber)
 		}
 	}
 
@@ -59,14 +92,38 @@ func NewSupersim(log log.Logger, envPrefix string, closeApp context.CancelCauseF
 
 	// Forward interop config
 	networkConfig.InteropAutoRelay = cliConfig.InteropAutoRelay
+	networkConfig.SuperchainERC20s = cliConfig.SuperchainERC20s
+	networkConfig.L1Tokens = cliConfig.L1Tokens
+
+	// Forward proposer config
+	networkConfig.ProposerInterval = cliConfig.ProposerInterval
+
+	// Forward block time & mining mode config
+	if err := cliConfig.ApplyMiningConfig(&networkConfig); err != nil {
+		if rpcCache != nil {
+			_ = rpcCache.Close(context.Background())
+		}
+		return nil, err
+	}
+
+	// Forward dev accounts & funded accounts config
+	if err := cliConfig.ApplyAccountsConfig(&networkConfig); err != nil {
+		if rpcCache != nil {
+			_ = rpcCache.Close(context.Background())
+		}
+		return nil, err
+	}
 
 	o, err := orchestrator.NewOrchestrator(log, closeApp, &networkConfig)
 	if err != nil {
+		if rpcCache != nil {
+			_ = rpcCache.Close(context.Background())
+		}
 		return nil, fmt.Errorf("failed to create orchestrator")
 	}
 
-	adminServer := admin.NewAdminServer(log, cliConfig.AdminPort)
-	return &Supersim{log, cliConfig, &networkConfig, o, adminServer}, nil
+	adminServer := admin.NewAdminServer(log, cliConfig.AdminPort, o)
+	return &Supersim{log, cliConfig, &networkConfig, o, adminServer, rpcCache}, nil
 }
 
 func (s *Supersim) Start(ctx context.Context) error {
@@ -80,6 +137,18 @@ func (s *Supersim) Start(ctx context.Context) error {
 
 	s.log.Info("supersim is ready")
 	s.log.Info(s.ConfigAsString())
+
+	if s.CLIConfig.ConfigOut != "" {
+		configJSON, err := s.ConfigAsJSON()
+		if err != nil {
+			return fmt.Errorf("failed to encode config: %w", err)
+		}
+		if err := os.WriteFile(s.CLIConfig.ConfigOut, configJSON, 0644); err != nil {
+			return fmt.Errorf("failed to write config to %s: %w", s.CLIConfig.ConfigOut, err)
+		}
+		s.log.Info("wrote config", "path", s.CLIConfig.ConfigOut)
+	}
+
 	return nil
 }
 
@@ -93,10 +162,56 @@ func (s *Supersim) Stop(ctx context.Context) error {
 		errs = append(errs, fmt.Errorf("admin server failed to stop: %w", err))
 	}
 
+	// The cache proxies serve anvil and must outlive it
+	if s.rpcCache != nil {
+		if err := s.rpcCache.Close(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("rpc cache failed to stop: %w", err))
+		}
+	}
+
 	s.log.Info("stopped supersim")
 	return errors.Join(errs...)
 }
 
+// loadGenesisDeployment loads the pre-generated genesis bundle or generates the genesis from the recipe
+func loadGenesisDeployment(log log.Logger, genesisConfig *config.GenesisCLIConfig) (*genesis.GenesisDeployment, error) {
+	if genesisConfig.Dir != "" {
+		log.Info("loading genesis", "dir", genesisConfig.Dir)
+		return genesis.LoadGenesisDeployment(genesisConfig.Dir)
+	}
+
+	artifacts := &worldgen.Artifacts{}
+	if genesisConfig.MonorepoArtifacts != "" {
+		url, err := worldgen.ParseLocalPathOrURL(genesisConfig.MonorepoArtifacts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse monorepo artifacts url: %w", err)
+		}
+		artifacts.MonorepoURL = url
+	}
+	if genesisConfig.PeripheryArtifacts != "" {
+		url, err := worldgen.ParseLocalPathOrURL(genesisConfig.PeripheryArtifacts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse periphery artifacts url: %w", err)
+		}
+		artifacts.PeripheryURL = url
+	}
+
+	userGenesis, err := genesisConfig.UserGenesis()
+	if err != nil {
+		return nil, err
+	}
+	recipe, err := genesisConfig.Recipe()
+	if err != nil {
+		return nil, err
+	}
+	return worldgen.LoadOrGenerateDeployment(context.Background(), log, recipe, artifacts, userGenesis, genesisConfig.CacheDir)
+}
+
+// ConfigAsJSON is the machine readable counterpart of `ConfigAsString`
+func (s *Supersim) ConfigAsJSON() ([]byte, error) {
+	return json.MarshalIndent(s.adminServer.ConfigDocument(), "", "  ")
+}
+
 // no-op dead code in the cliapp lifecycle
 func (s *Supersim) Stopped() bool {
 	return false
@@ -104,7 +219,7 @@ func (s *Supersim) Stopped() bool {
 
 func (s *Supersim) ConfigAsString() string {
 	var b strings.Builder
-	fmt.Fprintln(&b, config.DefaultSecretsConfigAsString())
+	fmt.Fprintln(&b, config.SecretsConfigAsString(s.NetworkConfig.L1Config.SecretsConfig))
 
 	fmt.Fprintln(&b, "Supersim Config")
 	fmt.Fprintln(&b, "-----------------------")
@@ -124,6 +239,24 @@ func (s *Supersim) ConfigAsString() string {
 		fmt.Fprintf(&b, " - CrossL2Inbox:               %s\n", predeploys.CrossL2Inbox)
 		fmt.Fprintf(&b, " - SuperchainTokenBridge:      %s\n", predeploys.SuperchainTokenBridge)
 		fmt.Fprintf(&b, " - SuperchainWETH:             %s\n", predeploys.SuperchainWETH)
+
+		if tokens := s.Orchestrator.SuperchainERC20s(); len(tokens) > 0 {
+			fmt.Fprintln(&b, "\nSuperchainERC20 Tokens:")
+			for _, token := range tokens {
+				fmt.Fprintf(&b, " - %s (%s): %s\n", token.Name, token.Symbol, token.Address)
+			}
+		}
+	}
+
+	if tokens := s.Orchestrator.L1Tokens(); len(tokens) > 0 {
+		fmt.Fprintln(&b, "\nL1 Tokens, deposited through the L1StandardBridge")
+		fmt.Fprintln(&b, "-----------------------")
+		for _, token := range tokens {
+			fmt.Fprintf(&b, " - %s (%s): %s\n", token.Name, token.Symbol, token.Address)
+			for _, l2Token := range token.L2Tokens {
+				fmt.Fprintf(&b, "   - %d: %s\n", l2Token.ChainID, l2Token.Address)
+			}
+		}
 	}
 
 	return b.String()
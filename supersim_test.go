@@ -1,8 +1,13 @@
 package supersim
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"math/big"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -14,12 +19,18 @@ import (
 	"github.com/ethereum-optimism/optimism/op-service/predeploys"
 	"github.com/ethereum-optimism/optimism/op-service/testlog"
 	registry "github.com/ethereum-optimism/superchain-registry/superchain"
+	"github.com/ethereum-optimism/supersim/admin"
 	"github.com/ethereum-optimism/supersim/bindings"
+	"github.com/ethereum-optimism/supersim/client"
 	"github.com/ethereum-optimism/supersim/config"
 	"github.com/ethereum-optimism/supersim/interop"
+	opsimulator "github.com/ethereum-optimism/supersim/opsimulator"
+	"github.com/ethereum-optimism/supersim/orchestrator"
+	"github.com/ethereum-optimism/supersim/proposer"
 	"github.com/ethereum-optimism/supersim/testutils"
 	"github.com/joho/godotenv"
 
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
@@ -253,6 +264,107 @@ func TestGenesisState(t *testing.T) {
 	}
 }
 
+func TestGenesisManifest(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createTestSuite(t, &config.CLIConfig{})
+	manifest := testSuite.Supersim.Orchestrator.Manifest()
+	require.NotNil(t, manifest)
+	require.Equal(t, testSuite.Supersim.Orchestrator.L1Chain().Config().ChainID, manifest.L1ChainID)
+
+	l2Configs := make(map[uint64]*config.ChainConfig)
+	for _, chain := range testSuite.Supersim.Orchestrator.L2Chains() {
+		l2Configs[chain.Config().ChainID] = chain.Config()
+	}
+
+	require.Equal(t, len(l2Configs), len(manifest.L2s))
+	for _, l2 := range manifest.L2s {
+		require.Contains(t, l2Configs, l2.ChainID)
+		require.Equal(t, common.Address(l2Configs[l2.ChainID].L2Config.L1Addresses.OptimismPortalProxy), l2.Addresses.OptimismPortalProxy)
+	}
+}
+
+func TestConfigAsJSON(t *testing.T) {
+	t.Parallel()
+
+	configOut := filepath.Join(t.TempDir(), "config.json")
+	testSuite := createTestSuite(t, &config.CLIConfig{ConfigOut: configOut})
+
+	configJSON, err := os.ReadFile(configOut)
+	require.NoError(t, err)
+
+	var doc orchestrator.ConfigDocument
+	require.NoError(t, json.Unmarshal(configJSON, &doc))
+
+	require.Equal(t, testSuite.Supersim.adminServer.Endpoint(), doc.AdminRPC)
+	require.Equal(t, testSuite.Supersim.Orchestrator.L1Chain().Endpoint(), doc.L1.RPCUrl)
+	require.Len(t, doc.Accounts, len(defaultTestAccounts))
+	require.Equal(t, defaultTestAccounts[0], doc.Accounts[0].Address.Hex())
+	require.NotNil(t, doc.Interop)
+
+	require.Len(t, doc.L2s, len(testSuite.Supersim.Orchestrator.L2Chains()))
+	for _, l2 := range doc.L2s {
+		require.Equal(t, testSuite.Supersim.Orchestrator.Endpoint(l2.ChainID), l2.RPCUrl)
+		require.NotEqual(t, registry.Address{}, l2.L1Addresses.OptimismPortalProxy)
+	}
+
+	// the admin server serves the same document
+	client, err := admin.NewClient(context.Background(), doc.AdminRPC)
+	require.NoError(t, err)
+	defer client.Close()
+
+	servedDoc, err := client.GetConfig(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, doc.L2s[0].L1Addresses.L1StandardBridgeProxy, servedDoc.L2s[0].L1Addresses.L1StandardBridgeProxy)
+	require.Equal(t, doc.L1.RPCUrl, servedDoc.L1.RPCUrl)
+}
+
+func TestL2OutputProposals(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createTestSuite(t, &config.CLIConfig{ProposerInterval: 1})
+	l1Client := testSuite.Supersim.Orchestrator.L1Chain().EthClient()
+
+	for _, l2Chain := range testSuite.Supersim.Orchestrator.L2Chains() {
+		disputeGameFactoryAddr := common.Address(l2Chain.Config().L2Config.L1Addresses.DisputeGameFactoryProxy)
+		require.NotEqual(t, common.Address{}, disputeGameFactoryAddr)
+
+		disputeGameFactory, err := opbindings.NewDisputeGameFactory(disputeGameFactoryAddr, l1Client)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		var gameCount *big.Int
+		for gameCount == nil || gameCount.Sign() == 0 {
+			select {
+			case <-ctx.Done():
+				t.Fatalf("no output proposed for chain %d", l2Chain.Config().ChainID)
+			case <-time.After(time.Second):
+			}
+
+			gameCount, err = disputeGameFactory.GameCount(&bind.CallOpts{Context: ctx})
+			require.NoError(t, err)
+		}
+
+		game, err := disputeGameFactory.GameAtIndex(&bind.CallOpts{Context: ctx}, common.Big0)
+		require.NoError(t, err)
+		require.Equal(t, proposer.PermissionedGameType, game.GameType)
+
+		faultDisputeGame, err := opbindings.NewFaultDisputeGame(game.Proxy, l1Client)
+		require.NoError(t, err)
+		l2BlockNumber, err := faultDisputeGame.L2BlockNumber(&bind.CallOpts{Context: ctx})
+		require.NoError(t, err)
+		rootClaim, err := faultDisputeGame.RootClaim(&bind.CallOpts{Context: ctx})
+		require.NoError(t, err)
+
+		// the proposed output matches the output of the underlying chain
+		outputRoot, err := proposer.OutputRoot(ctx, l2Chain.EthClient(), l2BlockNumber.Uint64())
+		require.NoError(t, err)
+		require.Equal(t, [32]byte(outputRoot), rootClaim)
+	}
+}
+
 func TestAccountBalances(t *testing.T) {
 	t.Parallel()
 
@@ -271,6 +383,102 @@ func TestAccountBalances(t *testing.T) {
 	}
 }
 
+func TestFundedAccounts(t *testing.T) {
+	t.Parallel()
+
+	secretsConfig := config.DefaultSecretsConfig
+	secretsConfig.Accounts = 12
+
+	fundedAddress := common.HexToAddress("0x0000000000000000000000000000000000001234")
+	testSuite := createTestSuite(t, &config.CLIConfig{
+		SecretsConfig:       &secretsConfig,
+		ChainFundedAccounts: map[string][]config.FundedAccount{"901": {{Address: fundedAddress, Amount: "1.5"}}},
+	})
+
+	devAccounts := secretsConfig.DevAccounts()
+	for _, l2Chain := range testSuite.Supersim.Orchestrator.L2Chains() {
+		// accounts derived beyond the defaults are funded on startup
+		balance, err := l2Chain.EthClient().BalanceAt(context.Background(), devAccounts[11].Address, nil)
+		require.NoError(t, err)
+		require.Equal(t, "0x21e19e0c9bab2400000", hexutil.EncodeBig(balance))
+
+		balance, err = l2Chain.EthClient().BalanceAt(context.Background(), fundedAddress, nil)
+		require.NoError(t, err)
+		if l2Chain.Config().ChainID == 901 {
+			require.Equal(t, "1500000000000000000", balance.String())
+		} else {
+			require.Zero(t, balance.Sign())
+		}
+	}
+}
+
+func TestAdminSnapshotRevert(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createTestSuite(t, &config.CLIConfig{})
+
+	adminClient, err := admin.NewClient(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer adminClient.Close()
+
+	address := common.HexToAddress("0x0000000000000000000000000000000000005678")
+	snapshotID, err := adminClient.Snapshot(context.Background())
+	require.NoError(t, err)
+
+	// funded on every chain after the snapshot
+	require.NoError(t, adminClient.Fund(context.Background(), config.FundedAccount{Address: address, Amount: "2"}, nil))
+	for _, chain := range append(testSuite.Supersim.Orchestrator.L2Chains(), testSuite.Supersim.Orchestrator.L1Chain()) {
+		balance, err := chain.EthClient().BalanceAt(context.Background(), address, nil)
+		require.NoError(t, err)
+		require.Equal(t, "2000000000000000000", balance.String())
+	}
+
+	require.NoError(t, adminClient.Revert(context.Background(), snapshotID))
+	for _, chain := range append(testSuite.Supersim.Orchestrator.L2Chains(), testSuite.Supersim.Orchestrator.L1Chain()) {
+		balance, err := chain.EthClient().BalanceAt(context.Background(), address, nil)
+		require.NoError(t, err)
+		require.Zero(t, balance.Sign())
+	}
+
+	// the snapshot is discarded once reverted to
+	require.Error(t, adminClient.Revert(context.Background(), snapshotID))
+}
+
+func TestClientDeposit(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createTestSuite(t, &config.CLIConfig{})
+
+	c, err := client.Dial(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer c.Close()
+
+	privateKey, err := testSuite.DevKeys.Secret(devkeys.UserKey(0))
+	require.NoError(t, err)
+
+	l1ChainID := testSuite.Supersim.Orchestrator.L1Chain().Config().ChainID
+	opts, err := bind.NewKeyedTransactorWithChainID(privateKey, new(big.Int).SetUint64(l1ChainID))
+	require.NoError(t, err)
+
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000009abc")
+	l2ChainID := testSuite.Supersim.NetworkConfig.L2Configs[0].ChainID
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	deposit, err := c.Deposit(ctx, opts, l2ChainID, recipient, big.NewInt(1e18))
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, deposit.L2Receipt.Status)
+
+	l2Client, err := ethclient.Dial(testSuite.Supersim.Orchestrator.Endpoint(l2ChainID))
+	require.NoError(t, err)
+	defer l2Client.Close()
+
+	balance, err := l2Client.BalanceAt(context.Background(), recipient, nil)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(1e18), balance)
+}
+
 func TestDepositTxSimpleEthDeposit(t *testing.T) {
 	t.Parallel()
 
@@ -857,6 +1065,103 @@ func TestAutoRelaySuperchainWETHTransferSucceeds(t *testing.T) {
 	assert.NoError(t, waitErr)
 }
 
+func TestClientSendMessageWaitForRelay(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createInteropTestSuite(t, config.CLIConfig{InteropAutoRelay: true})
+	privateKey, err := testSuite.DevKeys.Secret(devkeys.UserKey(0))
+	require.NoError(t, err)
+
+	c, err := client.Dial(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer c.Close()
+
+	destinationTransactor, err := bind.NewKeyedTransactorWithChainID(privateKey, testSuite.DestChainID)
+	require.NoError(t, err)
+
+	destEthClient, err := ethclient.Dial(testSuite.Supersim.Orchestrator.Endpoint(testSuite.DestChainID.Uint64()))
+	require.NoError(t, err)
+	defer destEthClient.Close()
+
+	simpleStorageAddress, deployTx, simpleStorage, err := bindings.DeploySimpleStorage(destinationTransactor, destEthClient)
+	require.NoError(t, err)
+	_, err = bind.WaitDeployed(context.Background(), destEthClient, deployTx)
+	require.NoError(t, err)
+
+	key := common.HexToHash("0xf00")
+	val := common.HexToHash("0xba7")
+	calldata, err := bindings.SimpleStorageParsedABI.Pack("set", key, val)
+	require.NoError(t, err)
+
+	sourceTransactor, err := bind.NewKeyedTransactorWithChainID(privateKey, testSuite.SourceChainID)
+	require.NoError(t, err)
+
+	sentMessage, err := c.SendMessage(context.Background(), sourceTransactor, testSuite.SourceChainID.Uint64(), testSuite.DestChainID.Uint64(), simpleStorageAddress, calldata)
+	require.NoError(t, err)
+	require.Equal(t, simpleStorageAddress, sentMessage.Message.Target)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	receipt, err := c.WaitForRelay(ctx, sentMessage.Hash)
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	newVal, err := simpleStorage.Get(&bind.CallOpts{}, key)
+	require.NoError(t, err)
+	require.Equal(t, val, common.Hash(newVal))
+}
+
+func TestClientRelayMessageDecodesRevert(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createInteropTestSuite(t, config.CLIConfig{})
+	privateKey, err := testSuite.DevKeys.Secret(devkeys.UserKey(0))
+	require.NoError(t, err)
+
+	c, err := client.Dial(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer c.Close()
+
+	destinationTransactor, err := bind.NewKeyedTransactorWithChainID(privateKey, testSuite.DestChainID)
+	require.NoError(t, err)
+
+	destEthClient, err := ethclient.Dial(testSuite.Supersim.Orchestrator.Endpoint(testSuite.DestChainID.Uint64()))
+	require.NoError(t, err)
+	defer destEthClient.Close()
+
+	simpleStorageAddress, deployTx, _, err := bindings.DeploySimpleStorage(destinationTransactor, destEthClient)
+	require.NoError(t, err)
+	_, err = bind.WaitDeployed(context.Background(), destEthClient, deployTx)
+	require.NoError(t, err)
+
+	sourceTransactor, err := bind.NewKeyedTransactorWithChainID(privateKey, testSuite.SourceChainID)
+	require.NoError(t, err)
+
+	// SimpleStorage has no fallback, the call of the target reverts
+	sentMessage, err := c.SendMessage(context.Background(), sourceTransactor, testSuite.SourceChainID.Uint64(), testSuite.DestChainID.Uint64(), simpleStorageAddress, common.FromHex("0xdeadbeef"))
+	require.NoError(t, err)
+
+	_, err = c.RelayMessage(context.Background(), destinationTransactor, sentMessage)
+	var relayErr *client.RelayError
+	require.ErrorAs(t, err, &relayErr)
+	require.Equal(t, "TargetCallFailed()", relayErr.Reason)
+	require.Equal(t, sentMessage.Hash, relayErr.MessageHash)
+
+	// the indexed message is relayable from its hash
+	waitErr := testutils.WaitForWithTimeout(context.Background(), 250*time.Millisecond, 5*time.Second, func() (bool, error) {
+		indexed, err := c.Message(context.Background(), sentMessage.Hash)
+		if errors.Is(err, interop.ErrMessageNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return bytes.Equal(indexed.Payload, sentMessage.Payload), nil
+	})
+	require.NoError(t, waitErr)
+}
+
 func TestForkAutoRelaySuperchainWETHTransferSucceeds(t *testing.T) {
 	testSuite := createForkedInteropTestSuite(t, ForkInteropTestSuiteOptions{interopAutoRelay: true})
 
@@ -905,3 +1210,462 @@ func TestForkAutoRelaySuperchainWETHTransferSucceeds(t *testing.T) {
 	})
 	assert.NoError(t, waitErr)
 }
+
+func TestForkWithLocalChains(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createTestSuite(t, &config.CLIConfig{
+		ForkConfig: &config.ForkCLIConfig{
+			Chains:         []string{"op"},
+			Network:        "mainnet",
+			LocalChains:    1,
+			InteropEnabled: true,
+		},
+	})
+
+	l2Chains := testSuite.Supersim.Orchestrator.L2Chains()
+	require.Equal(t, 2, len(l2Chains))
+
+	l1Client := testSuite.Supersim.Orchestrator.L1Chain().EthClient()
+	for _, chain := range l2Chains {
+		cfg := chain.Config()
+
+		// every l2 depends on every other l2
+		require.Equal(t, []uint64{otherChainID(l2Chains, cfg.ChainID)}, cfg.L2Config.DependencySet)
+		if cfg.ForkConfig != nil {
+			continue
+		}
+
+		// the local chain's l1 deployment is present on the forked l1
+		require.Equal(t, testSuite.Supersim.Orchestrator.L1Chain().Config().ChainID, cfg.L2Config.L1ChainID)
+		code, err := l1Client.CodeAt(context.Background(), common.Address(cfg.L2Config.L1Addresses.OptimismPortalProxy), nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, code, "OptimismPortalProxy is not deployed")
+	}
+}
+
+func otherChainID(chains []config.Chain, chainID uint64) uint64 {
+	for _, chain := range chains {
+		if chain.Config().ChainID != chainID {
+			return chain.Config().ChainID
+		}
+	}
+	return 0
+}
+
+func TestRefork(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createForkedInteropTestSuite(t, ForkInteropTestSuiteOptions{})
+	o := testSuite.Supersim.Orchestrator
+	ctx := context.Background()
+
+	l1ForkHeight := o.L1Chain().Config().ForkConfig.BlockNumber
+	result, err := o.Refork(ctx, &orchestrator.ReforkRequest{})
+	require.NoError(t, err)
+
+	doc := o.ConfigDocument()
+	require.GreaterOrEqual(t, result.L1BlockNumber, l1ForkHeight)
+	require.Equal(t, result.L1BlockNumber, doc.L1.ForkBlockNumber)
+	for _, chainDoc := range doc.L2s {
+		require.Equal(t, result.L2BlockNumbers[chainDoc.ChainID], chainDoc.ForkBlockNumber)
+	}
+	for _, chain := range o.L2Chains() {
+		// interop predeploys are re-applied
+		code, err := chain.EthClient().CodeAt(ctx, predeploys.CrossL2InboxAddr, nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, code)
+	}
+}
+
+func TestAdminSendERC20(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		token     common.Address
+		autoRelay bool
+	}{
+		// wrapped from the sender's ETH, relayed by the admin call
+		{name: "SuperchainWETH", token: predeploys.SuperchainWETHAddr},
+		{name: "L2NativeSuperchainERC20", token: common.HexToAddress(opsimulator.L2NativeSuperchainERC20Addr), autoRelay: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			testSuite := createInteropTestSuite(t, config.CLIConfig{InteropAutoRelay: tt.autoRelay})
+			sender := testSuite.Supersim.NetworkConfig.L1Config.SecretsConfig.DevAccounts()[0].Address
+			recipient := common.HexToAddress("0x000000000000000000000000000000000000beef")
+
+			adminClient, err := admin.NewClient(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+			require.NoError(t, err)
+			defer adminClient.Close()
+
+			if tt.token != predeploys.SuperchainWETHAddr {
+				require.NoError(t, adminClient.Fund(context.Background(), config.FundedAccount{Address: sender, Amount: "10", Token: &tt.token}, []uint64{testSuite.SourceChainID.Uint64()}))
+			}
+
+			result, err := adminClient.SendERC20(context.Background(), &orchestrator.SendERC20Request{
+				Token:       tt.token,
+				From:        sender,
+				To:          &recipient,
+				Amount:      "1.5",
+				Source:      testSuite.SourceChainID.Uint64(),
+				Destination: testSuite.DestChainID.Uint64(),
+			})
+			require.NoError(t, err)
+			require.Equal(t, uint8(18), result.Decimals)
+			require.Equal(t, "1.5", config.FormatAmount(result.DestinationBalance.ToInt(), result.Decimals))
+
+			relayReceipt, err := testSuite.DestEthClient.TransactionReceipt(context.Background(), result.RelayTxHash)
+			require.NoError(t, err)
+			require.Equal(t, types.ReceiptStatusSuccessful, relayReceipt.Status)
+
+			if tt.token == predeploys.SuperchainWETHAddr {
+				require.Zero(t, result.SourceBalance.ToInt().Sign())
+			} else {
+				require.Equal(t, "8.5", config.FormatAmount(result.SourceBalance.ToInt(), result.Decimals))
+			}
+		})
+	}
+}
+
+func TestSuperchainERC20Tokens(t *testing.T) {
+	t.Parallel()
+
+	holder := common.HexToAddress("0x000000000000000000000000000000000000abcd")
+	testSuite := createInteropTestSuite(t, config.CLIConfig{
+		InteropAutoRelay: true,
+		SuperchainERC20s: []config.Token{{Name: "My Token", Symbol: "MTK", Decimals: 6, Balances: []config.FundedAccount{{Address: holder, Amount: "100"}}}},
+
+		// gas of the bridge transaction
+		FundedAccounts: []config.FundedAccount{{Address: holder, Amount: "1"}},
+	})
+
+	tokens := testSuite.Supersim.Orchestrator.SuperchainERC20s()
+	require.Len(t, tokens, 1)
+	token := tokens[0].Address
+
+	for _, ethClient := range []*ethclient.Client{testSuite.SourceEthClient, testSuite.DestEthClient} {
+		erc20, err := bindings.NewL2NativeSuperchainERC20(token, ethClient)
+		require.NoError(t, err)
+
+		symbol, err := erc20.Symbol(&bind.CallOpts{})
+		require.NoError(t, err)
+		require.Equal(t, "MTK", symbol)
+		decimals, err := erc20.Decimals(&bind.CallOpts{})
+		require.NoError(t, err)
+		require.Equal(t, uint8(6), decimals)
+
+		balance, err := erc20.BalanceOf(&bind.CallOpts{}, holder)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(100_000_000), balance)
+	}
+
+	adminClient, err := admin.NewClient(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer adminClient.Close()
+
+	// bridged like any other SuperchainERC20
+	result, err := adminClient.SendERC20(context.Background(), &orchestrator.SendERC20Request{
+		Token:       token,
+		From:        holder,
+		Amount:      "25",
+		Source:      testSuite.SourceChainID.Uint64(),
+		Destination: testSuite.DestChainID.Uint64(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "75", config.FormatAmount(result.SourceBalance.ToInt(), result.Decimals))
+	require.Equal(t, "125", config.FormatAmount(result.DestinationBalance.ToInt(), result.Decimals))
+
+	// deployed once running, listed in the config
+	deployment, err := adminClient.DeploySuperchainERC20(context.Background(), config.Token{Name: "Other Token", Symbol: "OTK", Decimals: 18})
+	require.NoError(t, err)
+	require.NotEqual(t, token, deployment.Address)
+
+	doc, err := adminClient.GetConfig(context.Background())
+	require.NoError(t, err)
+	require.Len(t, doc.Interop.Tokens, 2)
+}
+
+func TestAdminDeployCreate2(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createTestSuite(t, &config.CLIConfig{})
+
+	adminClient, err := admin.NewClient(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer adminClient.Close()
+
+	// creates a contract returning 42
+	runtimeCode := common.FromHex("0x602a60005260206000f3")
+	initCode := common.FromHex("0x69602a60005260206000f3600052600a6016f3")
+	req := &orchestrator.DeployCreate2Request{InitCode: initCode, Salt: common.HexToHash("0x1234"), IncludeL1: true}
+
+	result, err := adminClient.DeployCreate2(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, result.Deployments, len(testSuite.Supersim.Orchestrator.L2Chains())+1)
+	require.Equal(t, testSuite.Supersim.Orchestrator.L1Chain().Config().ChainID, result.Deployments[0].ChainID)
+
+	chains := append(testSuite.Supersim.Orchestrator.L2Chains(), testSuite.Supersim.Orchestrator.L1Chain())
+	for _, chain := range chains {
+		code, err := chain.EthClient().CodeAt(context.Background(), result.Address, nil)
+		require.NoError(t, err)
+		require.Equal(t, runtimeCode, code)
+	}
+	for _, deployment := range result.Deployments {
+		require.Equal(t, result.Address, deployment.Address)
+		require.NotEqual(t, common.Hash{}, deployment.TxHash)
+	}
+
+	// deploying again is a no-op
+	result, err = adminClient.DeployCreate2(context.Background(), req)
+	require.NoError(t, err)
+	for _, deployment := range result.Deployments {
+		require.Equal(t, common.Hash{}, deployment.TxHash)
+	}
+}
+
+func TestClientDepositERC20(t *testing.T) {
+	t.Parallel()
+
+	devKeys, err := devkeys.NewMnemonicDevKeys(devkeys.TestMnemonic)
+	require.NoError(t, err)
+	key, err := devKeys.Secret(devkeys.UserKey(0))
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	testSuite := createTestSuite(t, &config.CLIConfig{
+		L1Tokens: []config.Token{{Name: "Test Token", Symbol: "TEST", Decimals: 6, Balances: []config.FundedAccount{{Address: sender, Amount: "100"}}}},
+	})
+
+	tokens := testSuite.Supersim.Orchestrator.L1Tokens()
+	require.Len(t, tokens, 1)
+	l1Token := tokens[0]
+	require.Len(t, l1Token.L2Tokens, len(testSuite.Supersim.NetworkConfig.L2Configs))
+
+	l1Client := testSuite.Supersim.Orchestrator.L1Chain().EthClient()
+	erc20, err := opbindings.NewERC20Caller(l1Token.Address, l1Client)
+	require.NoError(t, err)
+	symbol, err := erc20.Symbol(&bind.CallOpts{})
+	require.NoError(t, err)
+	require.Equal(t, "TEST", symbol)
+	balance, err := erc20.BalanceOf(&bind.CallOpts{}, sender)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100_000_000), balance)
+
+	c, err := client.Dial(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer c.Close()
+
+	l1ChainID := testSuite.Supersim.Orchestrator.L1Chain().Config().ChainID
+	opts, err := bind.NewKeyedTransactorWithChainID(key, new(big.Int).SetUint64(l1ChainID))
+	require.NoError(t, err)
+
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000009abc")
+	l2ChainID := testSuite.Supersim.NetworkConfig.L2Configs[0].ChainID
+	l2Token, ok := l1Token.L2Token(l2ChainID)
+	require.True(t, ok)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	_, err = c.DepositERC20(ctx, opts, l2ChainID, l1Token.Address, l2Token, recipient, big.NewInt(25_000_000))
+	require.NoError(t, err)
+
+	l2Client, err := ethclient.Dial(testSuite.Supersim.Orchestrator.Endpoint(l2ChainID))
+	require.NoError(t, err)
+	defer l2Client.Close()
+
+	l2ERC20, err := opbindings.NewOptimismMintableERC20Caller(l2Token, l2Client)
+	require.NoError(t, err)
+	remoteToken, err := l2ERC20.RemoteToken(&bind.CallOpts{})
+	require.NoError(t, err)
+	require.Equal(t, l1Token.Address, remoteToken)
+	decimals, err := l2ERC20.Decimals(&bind.CallOpts{})
+	require.NoError(t, err)
+	require.Equal(t, uint8(6), decimals)
+	balance, err = l2ERC20.BalanceOf(&bind.CallOpts{}, recipient)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(25_000_000), balance)
+
+	// deploying again finds the deployed tokens
+	adminClient, err := admin.NewClient(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer adminClient.Close()
+
+	deployment, err := adminClient.DeployL1Token(context.Background(), config.Token{Name: "Test Token", Symbol: "TEST", Decimals: 6})
+	require.NoError(t, err)
+	require.Equal(t, l1Token.Address, deployment.Address)
+	require.Equal(t, l2Token, deployment.L2Tokens[0].Address)
+	require.Equal(t, common.Hash{}, deployment.L2Tokens[0].TxHash)
+}
+
+func TestAdminReplayCrossDomainMessage(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createTestSuite(t, &config.CLIConfig{})
+
+	devKeys, err := devkeys.NewMnemonicDevKeys(devkeys.TestMnemonic)
+	require.NoError(t, err)
+	key, err := devKeys.Secret(devkeys.UserKey(0))
+	require.NoError(t, err)
+
+	l2Config := testSuite.Supersim.NetworkConfig.L2Configs[0]
+	var l2Chain config.Chain
+	for _, chain := range testSuite.Supersim.Orchestrator.L2Chains() {
+		if chain.Config().ChainID == l2Config.ChainID {
+			l2Chain = chain
+		}
+	}
+	require.NotNil(t, l2Chain)
+
+	// the target reverts until its code is removed
+	target := common.HexToAddress("0x0000000000000000000000000000000000009def")
+	require.NoError(t, l2Chain.SetCode(context.Background(), nil, target, "0xfe"))
+
+	l1Chain := testSuite.Supersim.Orchestrator.L1Chain()
+	l1Messenger := common.Address(l2Config.L2Config.L1Addresses.L1CrossDomainMessengerProxy)
+	messenger, err := opbindings.NewCrossDomainMessengerTransactor(l1Messenger, l1Chain.EthClient())
+	require.NoError(t, err)
+
+	opts, err := bind.NewKeyedTransactorWithChainID(key, new(big.Int).SetUint64(l1Chain.Config().ChainID))
+	require.NoError(t, err)
+	tx, err := messenger.SendMessage(opts, target, []byte("hello"), 100_000)
+	require.NoError(t, err)
+	_, err = bind.WaitMined(context.Background(), l1Chain.EthClient(), tx)
+	require.NoError(t, err)
+
+	adminClient, err := admin.NewClient(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer adminClient.Close()
+
+	var msgHash common.Hash
+	require.Eventually(t, func() bool {
+		msgs, err := adminClient.ListCrossDomainMessages(context.Background())
+		require.NoError(t, err)
+		for _, msg := range msgs {
+			if msg.Target == target && msg.Status == "failed" {
+				require.Equal(t, "l1tol2", msg.Direction)
+				require.Equal(t, l2Config.ChainID, msg.Destination)
+				msgHash = msg.MessageHash
+				return true
+			}
+		}
+		return false
+	}, 30*time.Second, 250*time.Millisecond)
+
+	// replaying fails while the target reverts
+	_, err = adminClient.ReplayCrossDomainMessage(context.Background(), msgHash, l2Config.ChainID)
+	require.Error(t, err)
+
+	require.NoError(t, l2Chain.SetCode(context.Background(), nil, target, "0x"))
+
+	msg, err := adminClient.ReplayCrossDomainMessage(context.Background(), msgHash, l2Config.ChainID)
+	require.NoError(t, err)
+	require.Equal(t, "replayed", msg.Status)
+	require.NotNil(t, msg.RelayedTxHash)
+	require.Len(t, msg.FailedTxHashes, 1)
+
+	_, err = adminClient.ReplayCrossDomainMessage(context.Background(), msgHash, 0)
+	require.Error(t, err, "expected a replayed message to not be replayable")
+}
+
+func TestAdminPauseSequencer(t *testing.T) {
+	t.Parallel()
+
+	testSuite := createTestSuite(t, &config.CLIConfig{})
+
+	adminClient, err := admin.NewClient(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer adminClient.Close()
+
+	l1Chain := testSuite.Supersim.Orchestrator.L1Chain()
+	l2ChainID := testSuite.Supersim.NetworkConfig.L2Configs[0].ChainID
+	l2Client, err := ethclient.Dial(testSuite.Supersim.Orchestrator.Endpoint(l2ChainID))
+	require.NoError(t, err)
+	defer l2Client.Close()
+
+	privateKey, err := testSuite.DevKeys.Secret(devkeys.UserKey(1))
+	require.NoError(t, err)
+	sender, err := testSuite.DevKeys.Address(devkeys.UserKey(1))
+	require.NoError(t, err)
+	recipient := common.HexToAddress("0x0000000000000000000000000000000000009abc")
+
+	nonce, err := l2Client.PendingNonceAt(context.Background(), sender)
+	require.NoError(t, err)
+	gasPrice, err := l2Client.SuggestGasPrice(context.Background())
+	require.NoError(t, err)
+	signTransfer := func(nonce uint64) *types.Transaction {
+		tx, err := types.SignNewTx(privateKey, types.LatestSignerForChainID(new(big.Int).SetUint64(l2ChainID)), &types.LegacyTx{
+			Nonce: nonce, GasPrice: new(big.Int).Mul(gasPrice, big.NewInt(2)), Gas: 21_000, To: &recipient, Value: big.NewInt(1),
+		})
+		require.NoError(t, err)
+		return tx
+	}
+
+	// queued transactions are not included while paused
+	require.NoError(t, adminClient.PauseSequencer(context.Background(), l2ChainID, &orchestrator.PauseSequencerRequest{Mode: opsimulator.SequencerQueue}))
+	queuedTx := signTransfer(nonce)
+	require.NoError(t, l2Client.SendTransaction(context.Background(), queuedTx))
+
+	_, err = l2Client.TransactionReceipt(context.Background(), queuedTx.Hash())
+	require.ErrorIs(t, err, ethereum.NotFound)
+
+	statuses, err := adminClient.GetSequencerStatus(context.Background())
+	require.NoError(t, err)
+	for _, status := range statuses {
+		require.Equal(t, status.ChainID == l2ChainID, status.Paused)
+		if status.Paused {
+			require.Equal(t, []common.Hash{queuedTx.Hash()}, status.QueuedTxs)
+		}
+	}
+
+	// deposits are still included
+	c, err := client.Dial(context.Background(), testSuite.Supersim.adminServer.Endpoint())
+	require.NoError(t, err)
+	defer c.Close()
+
+	depositKey, err := testSuite.DevKeys.Secret(devkeys.UserKey(0))
+	require.NoError(t, err)
+	opts, err := bind.NewKeyedTransactorWithChainID(depositKey, new(big.Int).SetUint64(l1Chain.Config().ChainID))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	deposit, err := c.Deposit(ctx, opts, l2ChainID, recipient, big.NewInt(1e18))
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, deposit.L2Receipt.Status)
+
+	result, err := adminClient.ResumeSequencer(context.Background(), l2ChainID)
+	require.NoError(t, err)
+	require.False(t, result.WindowElapsed)
+	require.Equal(t, []common.Hash{queuedTx.Hash()}, result.IncludedTxs)
+
+	receipt, err := bind.WaitMined(ctx, l2Client, queuedTx)
+	require.NoError(t, err)
+	require.Equal(t, types.ReceiptStatusSuccessful, receipt.Status)
+
+	// transactions are refused in reject mode
+	require.NoError(t, adminClient.PauseSequencer(context.Background(), l2ChainID, &orchestrator.PauseSequencerRequest{Mode: opsimulator.SequencerReject}))
+	require.Error(t, l2Client.SendTransaction(context.Background(), signTransfer(nonce+1)))
+	_, err = adminClient.ResumeSequencer(context.Background(), l2ChainID)
+	require.NoError(t, err)
+
+	// queued transactions are dropped once the sequencing window elapsed
+	require.NoError(t, adminClient.PauseSequencer(context.Background(), l2ChainID, &orchestrator.PauseSequencerRequest{SequencingWindow: 1}))
+	droppedTx := signTransfer(nonce + 1)
+	require.NoError(t, l2Client.SendTransaction(context.Background(), droppedTx))
+	require.NoError(t, l1Chain.EthClient().Client().CallContext(context.Background(), nil, "anvil_mine", uint64(2)))
+
+	result, err = adminClient.ResumeSequencer(context.Background(), l2ChainID)
+	require.NoError(t, err)
+	require.True(t, result.WindowElapsed)
+	require.Equal(t, []common.Hash{droppedTx.Hash()}, result.DroppedTxs)
+	require.Empty(t, result.IncludedTxs)
+
+	_, err = adminClient.ResumeSequencer(context.Background(), l2ChainID)
+	require.Error(t, err, "expected resuming a running sequencer to fail")
+}
@@ -78,3 +78,51 @@ func (c *MockChain) SetStorageAt(ctx context.Context, result interface{}, addres
 func (c *MockChain) SetIntervalMining(ctx context.Context, result interface{}, interval int64) error {
 	return nil
 }
+
+func (c *MockChain) SetAutomine(ctx context.Context, result interface{}, enabled bool) error {
+	return nil
+}
+
+func (c *MockChain) SetNonce(ctx context.Context, result interface{}, address common.Address, nonce uint64) error {
+	return nil
+}
+
+func (c *MockChain) Snapshot(ctx context.Context, result interface{}) error {
+	return nil
+}
+
+func (c *MockChain) Revert(ctx context.Context, result interface{}, snapshotID string) error {
+	return nil
+}
+
+func (c *MockChain) Reset(ctx context.Context, result interface{}, forkURL string, blockNumber uint64) error {
+	return nil
+}
+
+func (c *MockChain) Mine(ctx context.Context, result interface{}, blocks uint64) error {
+	return nil
+}
+
+func (c *MockChain) SetNextBlockTimestamp(ctx context.Context, result interface{}, timestamp uint64) error {
+	return nil
+}
+
+func (c *MockChain) IncreaseTime(ctx context.Context, result interface{}, seconds uint64) error {
+	return nil
+}
+
+func (c *MockChain) SetBlockTimestampInterval(ctx context.Context, result interface{}, seconds uint64) error {
+	return nil
+}
+
+func (c *MockChain) RemoveBlockTimestampInterval(ctx context.Context, result interface{}) error {
+	return nil
+}
+
+func (c *MockChain) ImpersonateAccount(ctx context.Context, result interface{}, address common.Address) error {
+	return nil
+}
+
+func (c *MockChain) StopImpersonatingAccount(ctx context.Context, result interface{}, address common.Address) error {
+	return nil
+}